@@ -0,0 +1,3 @@
+data "readarr_author_lookup" "example" {
+  term = "Leo Tolstoy"
+}
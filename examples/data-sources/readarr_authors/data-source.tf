@@ -1,2 +1,10 @@
 data "readarr_authors" "example" {
 }
+
+data "readarr_authors" "filtered" {
+  monitored          = true
+  status             = "continuing"
+  quality_profile_id = 1
+  path_prefix        = "/books"
+  name_regex         = "^J\\.R\\.R\\."
+}
@@ -0,0 +1,3 @@
+data "readarr_blocklist" "example" {
+  indexer = "Example"
+}
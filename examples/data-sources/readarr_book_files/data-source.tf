@@ -0,0 +1,3 @@
+data "readarr_book_files" "example" {
+  author_id = 1
+}
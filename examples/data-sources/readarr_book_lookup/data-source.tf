@@ -0,0 +1,3 @@
+data "readarr_book_lookup" "example" {
+  term = "isbn:9780261103573"
+}
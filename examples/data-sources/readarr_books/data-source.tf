@@ -0,0 +1,5 @@
+data "readarr_books" "example" {
+  author_id = 1
+  monitored = true
+  released  = true
+}
@@ -0,0 +1,5 @@
+data "readarr_calendar" "example" {
+  start               = "2023-10-01T00:00:00Z"
+  end                 = "2023-10-31T00:00:00Z"
+  include_unmonitored = true
+}
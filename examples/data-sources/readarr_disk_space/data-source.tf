@@ -0,0 +1,3 @@
+data "readarr_disk_space" "example" {
+  root_folder_path = "/books"
+}
@@ -0,0 +1,3 @@
+data "readarr_editions" "example" {
+  book_id = 1
+}
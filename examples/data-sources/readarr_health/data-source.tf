@@ -0,0 +1,3 @@
+data "readarr_health" "example" {
+  fail_on = "error"
+}
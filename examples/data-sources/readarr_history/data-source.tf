@@ -0,0 +1,5 @@
+data "readarr_history" "example" {
+  event_type = "bookFileImported"
+  start      = "2023-01-01T00:00:00Z"
+  end        = "2023-02-01T00:00:00Z"
+}
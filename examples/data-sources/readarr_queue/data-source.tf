@@ -0,0 +1,3 @@
+data "readarr_queue" "example" {
+  include_unknown_author_items = true
+}
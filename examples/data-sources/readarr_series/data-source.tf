@@ -0,0 +1,4 @@
+data "readarr_series" "example" {
+  author_id = 1
+  title     = "The Lord of the Rings"
+}
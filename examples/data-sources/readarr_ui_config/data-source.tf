@@ -0,0 +1,2 @@
+data "readarr_ui_config" "example" {
+}
@@ -0,0 +1,4 @@
+data "readarr_wanted_missing" "example" {
+  monitored = true
+  author_id = 1
+}
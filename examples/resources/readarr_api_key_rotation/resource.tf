@@ -0,0 +1,10 @@
+resource "readarr_api_key_rotation" "example" {
+  triggers = {
+    rotation = "2023-01"
+  }
+}
+
+output "readarr_api_key" {
+  value     = readarr_api_key_rotation.example.api_key
+  sensitive = true
+}
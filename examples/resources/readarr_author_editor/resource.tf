@@ -0,0 +1,16 @@
+data "readarr_authors" "example" {
+}
+
+resource "readarr_tag" "example" {
+  label = "imported"
+}
+
+resource "readarr_author_editor" "example" {
+  author_ids          = [for author in data.readarr_authors.example.authors : author.id]
+  monitored           = true
+  quality_profile_id  = 1
+  metadata_profile_id = 1
+  root_folder_path    = "/books"
+  move_files          = true
+  tags                = [readarr_tag.example.id]
+}
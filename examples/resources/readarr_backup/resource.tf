@@ -0,0 +1,7 @@
+resource "readarr_backup" "example" {
+  download_path = "/tmp/readarr_backup.zip"
+  timeout       = 300
+  triggers = {
+    date = "2023-01-01"
+  }
+}
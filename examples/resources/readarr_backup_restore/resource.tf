@@ -0,0 +1,4 @@
+resource "readarr_backup_restore" "example" {
+  file_path = "/tmp/readarr_backup.zip"
+  timeout   = 300
+}
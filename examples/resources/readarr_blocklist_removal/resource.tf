@@ -0,0 +1,7 @@
+resource "readarr_blocklist_removal" "example" {
+  author_id          = 1
+  source_title_regex = "(?i)epub"
+  triggers = {
+    date = "2023-01-01"
+  }
+}
@@ -0,0 +1,13 @@
+data "readarr_book_lookup" "example" {
+  term = "isbn:9780261103573"
+}
+
+resource "readarr_book" "example" {
+  foreign_book_id     = data.readarr_book_lookup.example.books[0].foreign_book_id
+  foreign_edition_id  = data.readarr_book_lookup.example.books[0].foreign_edition_id
+  monitored           = true
+  any_edition_ok      = false
+  quality_profile_id  = 1
+  metadata_profile_id = 1
+  root_folder_path    = "/books"
+}
@@ -0,0 +1,10 @@
+resource "readarr_command" "example" {
+  name = "RefreshAuthor"
+  body = jsonencode({
+    authorId = 1
+  })
+  timeout = 300
+  triggers = {
+    version = "1"
+  }
+}
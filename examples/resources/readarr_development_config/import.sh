@@ -0,0 +1,2 @@
+# import does not need parameters
+terraform import readarr_development_config.example ""
\ No newline at end of file
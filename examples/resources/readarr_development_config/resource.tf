@@ -0,0 +1,7 @@
+resource "readarr_development_config" "example" {
+  metadata_source      = "https://metadata.example.com"
+  console_log_level    = "info"
+  log_sql              = false
+  log_rotate           = 50
+  filter_sentry_events = true
+}
@@ -0,0 +1,8 @@
+data "readarr_queue" "example" {
+}
+
+resource "readarr_queue_removal" "example" {
+  queue_ids          = [for item in data.readarr_queue.example.items : item.id if item.tracked_download_status == "error"]
+  remove_from_client = true
+  blocklist          = true
+}
@@ -0,0 +1,6 @@
+resource "readarr_series_monitor" "example" {
+  author_id           = 1
+  series_id           = 10
+  monitored           = true
+  unmonitor_on_delete = true
+}
@@ -0,0 +1,11 @@
+resource "readarr_ui_config" "example" {
+  theme                       = "dark"
+  first_day_of_week           = 1
+  calendar_week_column_header = "ddd D/M"
+  short_date_format           = "DD MMM YYYY"
+  long_date_format            = "dddd, D MMMM YYYY"
+  time_format                 = "HH:mm"
+  show_relative_dates         = true
+  enable_color_impaired_mode  = false
+  ui_language                 = 1
+}
@@ -0,0 +1,17 @@
+package helpers
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// TimeValue converts a time into its RFC3339 string representation.
+// Zero values, which readarr returns for unknown dates, are converted to null.
+func TimeValue(t time.Time) types.String {
+	if t.IsZero() {
+		return types.StringNull()
+	}
+
+	return types.StringValue(t.Format(time.RFC3339))
+}
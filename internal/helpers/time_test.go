@@ -0,0 +1,39 @@
+package helpers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTimeValue(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		time     time.Time
+		expected types.String
+	}{
+		"zero": {
+			time:     time.Time{},
+			expected: types.StringNull(),
+		},
+		"utc": {
+			time:     time.Date(2023, 10, 14, 8, 30, 0, 0, time.UTC),
+			expected: types.StringValue("2023-10-14T08:30:00Z"),
+		},
+		"offset": {
+			time:     time.Date(2023, 10, 14, 8, 30, 0, 0, time.FixedZone("CEST", 7200)),
+			expected: types.StringValue("2023-10-14T08:30:00+02:00"),
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, test.expected, TimeValue(test.time))
+		})
+	}
+}
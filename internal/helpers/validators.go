@@ -0,0 +1,36 @@
+package helpers
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = regexValidator{}
+
+// regexValidator checks that a string compiles as a regular expression.
+type regexValidator struct{}
+
+// ValidRegex returns a validator which ensures that any configured value is a valid regular expression.
+func ValidRegex() validator.String {
+	return regexValidator{}
+}
+
+func (v regexValidator) Description(_ context.Context) string {
+	return "value must be a valid regular expression"
+}
+
+func (v regexValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v regexValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := regexp.Compile(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Regular Expression", "Unable to compile "+req.ConfigValue.ValueString()+", got error: "+err.Error())
+	}
+}
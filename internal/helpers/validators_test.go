@@ -0,0 +1,53 @@
+package helpers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidRegex(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		value types.String
+		error bool
+	}{
+		"null": {
+			value: types.StringNull(),
+			error: false,
+		},
+		"unknown": {
+			value: types.StringUnknown(),
+			error: false,
+		},
+		"valid": {
+			value: types.StringValue("^Author.*(EPUB|MOBI)$"),
+			error: false,
+		},
+		"invalid": {
+			value: types.StringValue("^Author[("),
+			error: true,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := validator.StringRequest{
+				Path:        path.Root("source_title_regex"),
+				ConfigValue: test.value,
+			}
+			resp := validator.StringResponse{}
+
+			ValidRegex().ValidateString(context.Background(), req, &resp)
+			assert.Equal(t, test.error, resp.Diagnostics.HasError())
+		})
+	}
+}
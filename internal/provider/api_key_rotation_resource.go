@@ -0,0 +1,275 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/devopsarr/readarr-go/readarr"
+	"github.com/devopsarr/terraform-provider-readarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const apiKeyRotationResourceName = "api_key_rotation"
+
+var (
+	// initializeAPIKey extracts the API key from the configuration served to the Readarr UI.
+	initializeAPIKey    = regexp.MustCompile(`apiKey: '([^']*)'`)
+	errUIAPIKeyNotFound = errors.New("API key not found in /initialize.js, the Readarr UI must be reachable without logging in")
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &APIKeyRotationResource{}
+
+func NewAPIKeyRotationResource() resource.Resource {
+	return &APIKeyRotationResource{}
+}
+
+// APIKeyRotationResource defines the API key rotation implementation.
+type APIKeyRotationResource struct {
+	client *readarr.APIClient
+}
+
+// APIKeyRotation describes the API key rotation data model.
+type APIKeyRotation struct {
+	Triggers types.Map    `tfsdk:"triggers"`
+	ID       types.String `tfsdk:"id"`
+	APIKey   types.String `tfsdk:"api_key"`
+	Timeout  types.Int64  `tfsdk:"timeout"`
+}
+
+func (r *APIKeyRotationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + apiKeyRotationResourceName
+}
+
+func (r *APIKeyRotationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "<!-- subcategory:System -->API key rotation resource.\nIt runs the `ResetApiKey` command on creation and uses the new key for the rest of the run. Any change to `triggers` runs it again. Destroying it has no effect on Readarr.\nThe old key stops working as soon as the command runs, so the new key is read back from the configuration served to the Readarr UI (`/initialize.js`). That page must be reachable without logging in, for example with authentication disabled for local addresses or handled by a reverse proxy. Otherwise the resource fails before resetting the key.\nThe provider configuration must be updated with the new key before the next run.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "API key rotation ID. Command ID.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary map of values that, when changed, will rotate the API key again.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"timeout": schema.Int64Attribute{
+				MarkdownDescription: "Seconds to wait for the new key. Defaults to 600.",
+				Optional:            true,
+			},
+			"api_key": schema.StringAttribute{
+				MarkdownDescription: "New API key.",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *APIKeyRotationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
+		r.client = client
+	}
+}
+
+func (r *APIKeyRotationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var rotation *APIKeyRotation
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &rotation)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	transport, ok := clientTransport(r.client)
+	if !ok {
+		resp.Diagnostics.AddError(helpers.ResourceError, "Unable to rotate the API key of a client not configured by the provider")
+
+		return
+	}
+
+	id := rotateAPIKey(ctx, r.client, transport, rotation.Timeout, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read the new key back with the new key itself
+	host, _, err := r.client.HostConfigAPI.GetHostConfig(ctx).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, apiKeyRotationResourceName, err))
+
+		return
+	}
+
+	rotation.ID = types.StringValue(strconv.Itoa(int(id)))
+	rotation.APIKey = types.StringValue(host.GetApiKey())
+
+	tflog.Trace(ctx, "created "+apiKeyRotationResourceName+": "+rotation.ID.ValueString())
+	// Generate resource state struct
+	resp.Diagnostics.Append(resp.State.Set(ctx, &rotation)...)
+}
+
+func (r *APIKeyRotationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// The rotation is a one shot action, the state is kept as is.
+	var rotation *APIKeyRotation
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &rotation)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "read "+apiKeyRotationResourceName+": "+rotation.ID.ValueString())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &rotation)...)
+}
+
+func (r *APIKeyRotationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Timeout only affects the creation, nothing to update in place.
+	var rotation *APIKeyRotation
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &rotation)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "updated "+apiKeyRotationResourceName+": "+rotation.ID.ValueString())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &rotation)...)
+}
+
+func (r *APIKeyRotationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// The previous key cannot be restored
+	tflog.Trace(ctx, "deleted "+apiKeyRotationResourceName)
+	resp.State.RemoveResource(ctx)
+}
+
+// rotateAPIKey runs the ResetApiKey command and switches the transport to the new key, returning the command ID.
+// The old key is rejected as soon as the command runs, so the new one is polled from the UI configuration.
+func rotateAPIKey(ctx context.Context, client *readarr.APIClient, transport *apiKeyTransport, timeout types.Int64, diags *diag.Diagnostics) int32 {
+	host, _, err := client.HostConfigAPI.GetHostConfig(ctx).Execute()
+	if err != nil {
+		diags.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, apiKeyRotationResourceName, err))
+
+		return 0
+	}
+
+	oldKey := host.GetApiKey()
+
+	// Make sure the new key can be read back before losing access.
+	key, err := readUIAPIKey(ctx, client)
+	if err != nil {
+		diags.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, apiKeyRotationResourceName, err))
+
+		return 0
+	}
+
+	if key != oldKey {
+		diags.AddError(helpers.ResourceError, "The API key served to the Readarr UI does not match the provider one, the key is not rotated")
+
+		return 0
+	}
+
+	id := startCommand(ctx, client, "ResetApiKey", nil, diags)
+	if diags.HasError() {
+		return 0
+	}
+
+	seconds := int64(defaultCommandTimeout)
+	if !timeout.IsNull() {
+		seconds = timeout.ValueInt64()
+	}
+
+	deadline := time.Now().Add(time.Duration(seconds) * time.Second)
+
+	for key == oldKey {
+		if time.Now().After(deadline) {
+			diags.AddError(helpers.ResourceError, "Timeout waiting for the new API key")
+
+			return 0
+		}
+
+		select {
+		case <-ctx.Done():
+			diags.AddError(helpers.ResourceError, fmt.Sprintf("Interrupted waiting for the new API key: %s", ctx.Err()))
+
+			return 0
+		case <-time.After(commandPollInterval):
+		}
+
+		if key, err = readUIAPIKey(ctx, client); err != nil {
+			diags.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, apiKeyRotationResourceName, err))
+
+			return 0
+		}
+	}
+
+	// Following requests of this run must use the new key
+	transport.SetKey(key)
+
+	if waitCommand(ctx, client, "ResetApiKey", id, timeout, diags) == nil {
+		return 0
+	}
+
+	return id
+}
+
+// readUIAPIKey reads the API key from the configuration served to the Readarr UI.
+// The request is sent without the API key on purpose, since the page must be reachable once the current key is revoked.
+func readUIAPIKey(ctx context.Context, client *readarr.APIClient) (string, error) {
+	basePath, err := client.GetConfig().ServerURLWithContext(ctx, "InitializeJsAPIService.GetInitializeJs")
+	if err != nil {
+		return "", err
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, basePath+"/initialize.js", nil)
+	if err != nil {
+		return "", err
+	}
+
+	httpResp, err := (&http.Client{}).Do(request)
+	if err != nil {
+		return "", err
+	}
+
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode >= http.StatusMultipleChoices {
+		return "", fmt.Errorf("%w: %s", errUIAPIKeyNotFound, httpResp.Status)
+	}
+
+	content, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		return "", err
+	}
+
+	match := initializeAPIKey.FindSubmatch(content)
+	if match == nil {
+		return "", errUIAPIKeyNotFound
+	}
+
+	return string(match[1]), nil
+}
@@ -0,0 +1,144 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"sync"
+	"testing"
+
+	"github.com/devopsarr/readarr-go/readarr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccAPIKeyRotationResource(t *testing.T) {
+	t.Parallel()
+
+	// A real rotation would lock out the other tests, it is covered by TestRotateAPIKey
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized
+			{
+				Config:      testAccAPIKeyRotationResourceConfig + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+		},
+	})
+}
+
+const testAccAPIKeyRotationResourceConfig = `
+resource "readarr_api_key_rotation" "test" {
+	triggers = {
+		rotation = "1"
+	}
+}
+`
+
+// fakeReadarr emulates the endpoints involved in the API key rotation.
+type fakeReadarr struct {
+	key        string
+	ui         string
+	commands   int
+	mu         sync.Mutex
+	uiNeedsKey bool
+}
+
+func (f *fakeReadarr) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if r.URL.Path == "/initialize.js" && (!f.uiNeedsKey || r.Header.Get(apiKeyHeader) == f.key) {
+		_, _ = w.Write([]byte(f.ui + f.key + "',\n};"))
+
+		return
+	}
+
+	if r.Header.Get(apiKeyHeader) != f.key {
+		w.WriteHeader(http.StatusUnauthorized)
+
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	switch {
+	case r.URL.Path == "/api/v1/config/host":
+		_, _ = w.Write([]byte(`{"id":1,"apiKey":"` + f.key + `"}`))
+	case r.URL.Path == "/api/v1/command" && r.Method == http.MethodPost:
+		// The key is replaced right after the command is accepted
+		f.commands++
+		f.key = "new"
+		_, _ = w.Write([]byte(`{"id":5,"name":"ResetApiKey","status":"queued"}`))
+	case r.URL.Path == "/api/v1/command/5":
+		_, _ = w.Write([]byte(`{"id":5,"name":"ResetApiKey","status":"completed"}`))
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func TestRotateAPIKey(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		ui         string
+		key        string
+		expected   string
+		commands   int
+		id         int32
+		uiNeedsKey bool
+	}{
+		"rotation": {
+			ui:       "window.Readarr = {\n  apiRoot: '/api/v1',\n  apiKey: '",
+			key:      "old",
+			expected: "new",
+			commands: 1,
+			id:       5,
+		},
+		"login required": {
+			ui:       "<html>login</html>'",
+			key:      "old",
+			expected: "old",
+		},
+		"ui behind api key": {
+			ui:         "window.Readarr = {\n  apiRoot: '/api/v1',\n  apiKey: '",
+			key:        "old",
+			expected:   "old",
+			uiNeedsKey: true,
+		},
+		"unauthorized": {
+			ui:       "window.Readarr = {\n  apiRoot: '/api/v1',\n  apiKey: '",
+			key:      "wrong",
+			expected: "wrong",
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			fake := &fakeReadarr{key: "old", ui: test.ui, uiNeedsKey: test.uiNeedsKey}
+			server := httptest.NewServer(fake)
+			t.Cleanup(server.Close)
+
+			transport := newAPIKeyTransport(test.key)
+			config := readarr.NewConfiguration()
+			config.HTTPClient = &http.Client{Transport: transport}
+			config.Servers[0].URL = server.URL
+
+			var diags diag.Diagnostics
+
+			id := rotateAPIKey(context.Background(), readarr.NewAPIClient(config), transport, types.Int64Value(10), &diags)
+			assert.Equal(t, test.id == 0, diags.HasError())
+			assert.Equal(t, test.id, id)
+			assert.Equal(t, test.expected, transport.Key())
+			assert.Equal(t, test.commands, fake.commands)
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"net/http"
+	"sync"
+
+	"github.com/devopsarr/readarr-go/readarr"
+)
+
+const apiKeyHeader = "X-API-Key"
+
+// apiKeyTransport adds the API key to every request sent by the provider client.
+// The key is guarded, since it can be replaced by the API key rotation while other resources are sending requests.
+type apiKeyTransport struct {
+	base http.RoundTripper
+	key  string
+	mu   sync.RWMutex
+}
+
+func newAPIKeyTransport(key string) *apiKeyTransport {
+	return &apiKeyTransport{
+		base: http.DefaultTransport,
+		key:  key,
+	}
+}
+
+// clientTransport returns the API key transport of a client configured by the provider.
+func clientTransport(client *readarr.APIClient) (*apiKeyTransport, bool) {
+	httpClient := client.GetConfig().HTTPClient
+	if httpClient == nil {
+		return nil, false
+	}
+
+	transport, ok := httpClient.Transport.(*apiKeyTransport)
+
+	return transport, ok
+}
+
+func (t *apiKeyTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	// A RoundTripper must not modify the original request.
+	clone := request.Clone(request.Context())
+	clone.Header.Set(apiKeyHeader, t.Key())
+
+	return t.base.RoundTrip(clone)
+}
+
+// Key returns the API key in use.
+func (t *apiKeyTransport) Key() string {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	return t.key
+}
+
+// SetKey replaces the API key for the following requests.
+func (t *apiKeyTransport) SetKey(key string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.key = key
+}
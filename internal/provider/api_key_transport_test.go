@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/devopsarr/readarr-go/readarr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAPIKeyTransport(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"appName":"` + r.Header.Get(apiKeyHeader) + `"}`))
+	}))
+	t.Cleanup(server.Close)
+
+	transport := newAPIKeyTransport("old")
+	config := readarr.NewConfiguration()
+	config.HTTPClient = &http.Client{Transport: transport}
+	config.Servers[0].URL = server.URL
+	client := readarr.NewAPIClient(config)
+
+	found, ok := clientTransport(client)
+	assert.True(t, ok)
+	assert.Same(t, transport, found)
+
+	// Requests keep running while the key is replaced.
+	var wg sync.WaitGroup
+
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			status, _, err := client.SystemAPI.GetSystemStatus(context.Background()).Execute()
+			assert.Nil(t, err)
+			assert.Contains(t, []string{"old", "new"}, status.GetAppName())
+		}()
+	}
+
+	transport.SetKey("new")
+	wg.Wait()
+
+	status, _, err := client.SystemAPI.GetSystemStatus(context.Background()).Execute()
+	assert.Nil(t, err)
+	assert.Equal(t, "new", status.GetAppName())
+	assert.Equal(t, "new", transport.Key())
+
+	_, ok = clientTransport(readarr.NewAPIClient(readarr.NewConfiguration()))
+	assert.False(t, ok)
+}
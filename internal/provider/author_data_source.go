@@ -77,6 +77,39 @@ func (d *AuthorDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 				Computed:            true,
 				ElementType:         types.StringType,
 			},
+			"sort_name": schema.StringAttribute{
+				MarkdownDescription: "Sort name.",
+				Computed:            true,
+			},
+			"clean_name": schema.StringAttribute{
+				MarkdownDescription: "Clean name.",
+				Computed:            true,
+			},
+			"added": schema.StringAttribute{
+				MarkdownDescription: "Date the author was added to the library.",
+				Computed:            true,
+			},
+			"ended": schema.BoolAttribute{
+				MarkdownDescription: "Ended flag.",
+				Computed:            true,
+			},
+			"links": schema.SetNestedAttribute{
+				MarkdownDescription: "External links.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: linkDataSourceSchema(),
+				},
+			},
+			"ratings": schema.SingleNestedAttribute{
+				MarkdownDescription: "Ratings.",
+				Computed:            true,
+				Attributes:          ratingsDataSourceSchema(),
+			},
+			"statistics": schema.SingleNestedAttribute{
+				MarkdownDescription: "Library statistics.",
+				Computed:            true,
+				Attributes:          authorStatisticsDataSourceSchema(),
+			},
 		},
 	}
 }
@@ -114,6 +147,44 @@ func (d *AuthorDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
+func linkDataSourceSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"url": schema.StringAttribute{
+			MarkdownDescription: "Link URL.",
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "Link name.",
+			Computed:            true,
+		},
+	}
+}
+
+func authorStatisticsDataSourceSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"book_count": schema.Int64Attribute{
+			MarkdownDescription: "Monitored book count.",
+			Computed:            true,
+		},
+		"total_book_count": schema.Int64Attribute{
+			MarkdownDescription: "Total book count.",
+			Computed:            true,
+		},
+		"book_file_count": schema.Int64Attribute{
+			MarkdownDescription: "Book file count.",
+			Computed:            true,
+		},
+		"size_on_disk": schema.Int64Attribute{
+			MarkdownDescription: "Size on disk in bytes.",
+			Computed:            true,
+		},
+		"percent_of_books": schema.Float64Attribute{
+			MarkdownDescription: "Percent of books on disk.",
+			Computed:            true,
+		},
+	}
+}
+
 func (a *Author) find(ctx context.Context, ID string, authors []*readarr.AuthorResource, diags *diag.Diagnostics) {
 	for _, author := range authors {
 		if author.GetForeignAuthorId() == ID {
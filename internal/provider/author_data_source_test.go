@@ -31,6 +31,7 @@ func TestAccAuthorDataSource(t *testing.T) {
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttrSet("data.readarr_author.test", "id"),
 					resource.TestCheckResourceAttr("data.readarr_author.test", "author_name", "Agatha Christie"),
+					resource.TestCheckResourceAttrSet("data.readarr_author.test", "statistics.size_on_disk"),
 				),
 			},
 		},
@@ -0,0 +1,269 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/devopsarr/readarr-go/readarr"
+	"github.com/devopsarr/terraform-provider-readarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const authorEditorResourceName = "author_editor"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &AuthorEditorResource{}
+
+func NewAuthorEditorResource() resource.Resource {
+	return &AuthorEditorResource{}
+}
+
+// AuthorEditorResource defines the author editor implementation.
+type AuthorEditorResource struct {
+	client *readarr.APIClient
+}
+
+// AuthorEditor describes the author editor data model.
+type AuthorEditor struct {
+	AuthorIDs         types.Set    `tfsdk:"author_ids"`
+	Tags              types.Set    `tfsdk:"tags"`
+	RootFolderPath    types.String `tfsdk:"root_folder_path"`
+	ID                types.String `tfsdk:"id"`
+	QualityProfileID  types.Int64  `tfsdk:"quality_profile_id"`
+	MetadataProfileID types.Int64  `tfsdk:"metadata_profile_id"`
+	Monitored         types.Bool   `tfsdk:"monitored"`
+	MoveFiles         types.Bool   `tfsdk:"move_files"`
+}
+
+func (r *AuthorEditorResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + authorEditorResourceName
+}
+
+func (r *AuthorEditorResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "<!-- subcategory:Authors -->Author editor resource.\nIt enforces the same settings on a set of [Authors](../resources/author) not managed by Terraform. Only the configured attributes are applied, the others are left untouched.\nOn destroy the authors are left as they are.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Author editor ID. Sorted list of the author IDs.",
+				Computed:            true,
+			},
+			"author_ids": schema.SetAttribute{
+				MarkdownDescription: "Author IDs.",
+				Required:            true,
+				ElementType:         types.Int64Type,
+			},
+			"monitored": schema.BoolAttribute{
+				MarkdownDescription: "Monitored flag.",
+				Optional:            true,
+			},
+			"quality_profile_id": schema.Int64Attribute{
+				MarkdownDescription: "Quality profile ID.",
+				Optional:            true,
+			},
+			"metadata_profile_id": schema.Int64Attribute{
+				MarkdownDescription: "Metadata profile ID.",
+				Optional:            true,
+			},
+			"root_folder_path": schema.StringAttribute{
+				MarkdownDescription: "Root folder path.",
+				Optional:            true,
+			},
+			"tags": schema.SetAttribute{
+				MarkdownDescription: "List of associated tags. It replaces the current author tags.",
+				Optional:            true,
+				ElementType:         types.Int64Type,
+			},
+			"move_files": schema.BoolAttribute{
+				MarkdownDescription: "Move files when the root folder changes.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *AuthorEditorResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
+		r.client = client
+	}
+}
+
+func (r *AuthorEditorResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var editor *AuthorEditor
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &editor)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Apply settings to authors
+	request := editor.read(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.AuthorEditorAPI.PutAuthorEditor(ctx).AuthorEditorResource(*request).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, authorEditorResourceName, err))
+
+		return
+	}
+
+	editor.ID = types.StringValue(joinIDs(request.GetAuthorIds()))
+
+	tflog.Trace(ctx, "created "+authorEditorResourceName+": "+editor.ID.ValueString())
+	// Generate resource state struct
+	resp.Diagnostics.Append(resp.State.Set(ctx, &editor)...)
+}
+
+func (r *AuthorEditorResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var editor *AuthorEditor
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &editor)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get authors current value
+	response, _, err := r.client.AuthorAPI.ListAuthor(ctx).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, authorEditorResourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+authorEditorResourceName+": "+editor.ID.ValueString())
+	// Map response body to resource schema attribute
+	editor.write(ctx, response, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &editor)...)
+}
+
+func (r *AuthorEditorResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Get plan values
+	var editor *AuthorEditor
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &editor)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Apply settings to authors
+	request := editor.read(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.AuthorEditorAPI.PutAuthorEditor(ctx).AuthorEditorResource(*request).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, authorEditorResourceName, err))
+
+		return
+	}
+
+	editor.ID = types.StringValue(joinIDs(request.GetAuthorIds()))
+
+	tflog.Trace(ctx, "updated "+authorEditorResourceName+": "+editor.ID.ValueString())
+	// Generate resource state struct
+	resp.Diagnostics.Append(resp.State.Set(ctx, &editor)...)
+}
+
+func (r *AuthorEditorResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Authors are left untouched
+	tflog.Trace(ctx, "deleted "+authorEditorResourceName)
+	resp.State.RemoveResource(ctx)
+}
+
+// write detects drift on the managed authors.
+// Authors no longer in the library are dropped, while the first value differing from the state is reported for each attribute.
+func (e *AuthorEditor) write(ctx context.Context, authors []readarr.AuthorResource, diags *diag.Diagnostics) {
+	var (
+		ids      []int32
+		tempDiag diag.Diagnostics
+	)
+
+	managed := make(map[int32]bool)
+	diags.Append(e.AuthorIDs.ElementsAs(ctx, &ids, false)...)
+
+	for _, id := range ids {
+		managed[id] = true
+	}
+
+	existing := make([]int32, 0, len(ids))
+
+	for i := range authors {
+		author := &authors[i]
+		if !managed[author.GetId()] {
+			continue
+		}
+
+		existing = append(existing, author.GetId())
+
+		if !e.Monitored.IsNull() && e.Monitored.ValueBool() != author.GetMonitored() {
+			e.Monitored = types.BoolValue(author.GetMonitored())
+		}
+
+		if !e.QualityProfileID.IsNull() && e.QualityProfileID.ValueInt64() != int64(author.GetQualityProfileId()) {
+			e.QualityProfileID = types.Int64Value(int64(author.GetQualityProfileId()))
+		}
+
+		if !e.MetadataProfileID.IsNull() && e.MetadataProfileID.ValueInt64() != int64(author.GetMetadataProfileId()) {
+			e.MetadataProfileID = types.Int64Value(int64(author.GetMetadataProfileId()))
+		}
+
+		if !e.RootFolderPath.IsNull() && e.RootFolderPath.ValueString() != author.GetRootFolderPath() {
+			e.RootFolderPath = types.StringValue(author.GetRootFolderPath())
+		}
+
+		if !e.Tags.IsNull() {
+			var tags types.Set
+
+			tags, tempDiag = types.SetValueFrom(ctx, types.Int64Type, author.GetTags())
+			diags.Append(tempDiag...)
+
+			if !e.Tags.Equal(tags) {
+				e.Tags = tags
+			}
+		}
+	}
+
+	e.AuthorIDs, tempDiag = types.SetValueFrom(ctx, types.Int64Type, existing)
+	diags.Append(tempDiag...)
+	e.ID = types.StringValue(joinIDs(existing))
+}
+
+func (e *AuthorEditor) read(ctx context.Context, diags *diag.Diagnostics) *readarr.AuthorEditorResource {
+	editor := readarr.NewAuthorEditorResource()
+	diags.Append(e.AuthorIDs.ElementsAs(ctx, &editor.AuthorIds, true)...)
+
+	if !e.Monitored.IsNull() {
+		editor.SetMonitored(e.Monitored.ValueBool())
+	}
+
+	if !e.QualityProfileID.IsNull() {
+		editor.SetQualityProfileId(int32(e.QualityProfileID.ValueInt64()))
+	}
+
+	if !e.MetadataProfileID.IsNull() {
+		editor.SetMetadataProfileId(int32(e.MetadataProfileID.ValueInt64()))
+	}
+
+	if !e.RootFolderPath.IsNull() {
+		editor.SetRootFolderPath(e.RootFolderPath.ValueString())
+	}
+
+	if !e.Tags.IsNull() {
+		diags.Append(e.Tags.ElementsAs(ctx, &editor.Tags, true)...)
+		editor.SetApplyTags(readarr.APPLYTAGS_REPLACE)
+	}
+
+	editor.SetMoveFiles(e.MoveFiles.ValueBool())
+
+	return editor
+}
@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccAuthorEditorResource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized Create
+			{
+				Config:      testAccAuthorEditorResourceConfig("[1]", "[]") + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Create and Read testing
+			{
+				PreConfig: rootFolderDSInit,
+				Config:    testAccAuthorResourceConfig("Stephen King", "stephenking", "3389") + testAccAuthorEditorResourceConfig("[readarr_author.test.id]", "[]"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("readarr_author_editor.test", "id", "readarr_author.test", "id"),
+					resource.TestCheckResourceAttr("readarr_author_editor.test", "tags.#", "0"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccAuthorResourceConfig("Stephen King", "stephenking", "3389") + testAccAuthorEditorResourceConfig("[readarr_author.test.id]", "[readarr_tag.test.id]"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("readarr_author_editor.test", "tags.#", "1"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccAuthorEditorResourceConfig(authors, tags string) string {
+	return fmt.Sprintf(`
+		resource "readarr_tag" "test" {
+			label = "authoreditor"
+		}
+
+		resource "readarr_author_editor" "test" {
+			author_ids = %s
+			monitored = false
+			quality_profile_id = 1
+			metadata_profile_id = 1
+			root_folder_path = "/config"
+			tags = %s
+		}
+	`, authors, tags)
+}
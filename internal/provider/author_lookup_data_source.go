@@ -0,0 +1,313 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"github.com/devopsarr/readarr-go/readarr"
+	"github.com/devopsarr/terraform-provider-readarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const authorLookupDataSourceName = "author_lookup"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &AuthorLookupDataSource{}
+
+func NewAuthorLookupDataSource() datasource.DataSource {
+	return &AuthorLookupDataSource{}
+}
+
+// AuthorLookupDataSource defines the author lookup implementation.
+type AuthorLookupDataSource struct {
+	client *readarr.APIClient
+}
+
+// AuthorLookup describes the author lookup data model.
+type AuthorLookup struct {
+	Authors types.List   `tfsdk:"authors"`
+	Term    types.String `tfsdk:"term"`
+	ID      types.String `tfsdk:"id"`
+}
+
+// LookupAuthor is part of AuthorLookup.
+type LookupAuthor struct {
+	Genres              types.Set    `tfsdk:"genres"`
+	Images              types.List   `tfsdk:"images"`
+	Ratings             types.Object `tfsdk:"ratings"`
+	AuthorName          types.String `tfsdk:"author_name"`
+	AuthorNameLastFirst types.String `tfsdk:"author_name_last_first"`
+	ForeignAuthorID     types.String `tfsdk:"foreign_author_id"`
+	Disambiguation      types.String `tfsdk:"disambiguation"`
+	Overview            types.String `tfsdk:"overview"`
+	Status              types.String `tfsdk:"status"`
+	TitleSlug           types.String `tfsdk:"title_slug"`
+	ID                  types.Int64  `tfsdk:"id"`
+}
+
+func (a LookupAuthor) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"genres":                 types.SetType{}.WithElementType(types.StringType),
+			"images":                 types.ListType{}.WithElementType(MediaCover{}.getType()),
+			"ratings":                Ratings{}.getType(),
+			"author_name":            types.StringType,
+			"author_name_last_first": types.StringType,
+			"foreign_author_id":      types.StringType,
+			"disambiguation":         types.StringType,
+			"overview":               types.StringType,
+			"status":                 types.StringType,
+			"title_slug":             types.StringType,
+			"id":                     types.Int64Type,
+		})
+}
+
+// Ratings is part of LookupAuthor.
+type Ratings struct {
+	Value      types.Float64 `tfsdk:"value"`
+	Popularity types.Float64 `tfsdk:"popularity"`
+	Votes      types.Int64   `tfsdk:"votes"`
+}
+
+func (r Ratings) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"value":      types.Float64Type,
+			"popularity": types.Float64Type,
+			"votes":      types.Int64Type,
+		})
+}
+
+// MediaCover is part of LookupAuthor.
+type MediaCover struct {
+	URL       types.String `tfsdk:"url"`
+	CoverType types.String `tfsdk:"cover_type"`
+	Extension types.String `tfsdk:"extension"`
+}
+
+func (m MediaCover) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"url":        types.StringType,
+			"cover_type": types.StringType,
+			"extension":  types.StringType,
+		})
+}
+
+func (d *AuthorLookupDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + authorLookupDataSourceName
+}
+
+func (d *AuthorLookupDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "<!-- subcategory:Authors -->Search the metadata provider for [Authors](../resources/author) to be added.",
+		Attributes: map[string]schema.Attribute{
+			// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"term": schema.StringAttribute{
+				MarkdownDescription: "Search term. It can be a name, an ISBN, an ASIN or a `readarr:` prefixed foreign author ID.",
+				Required:            true,
+			},
+			"authors": schema.ListNestedAttribute{
+				MarkdownDescription: "Matching author list.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							MarkdownDescription: "Author ID. Zero if the author is not in the library.",
+							Computed:            true,
+						},
+						"foreign_author_id": schema.StringAttribute{
+							MarkdownDescription: "Foreign author ID.",
+							Computed:            true,
+						},
+						"author_name": schema.StringAttribute{
+							MarkdownDescription: "Author name.",
+							Computed:            true,
+						},
+						"author_name_last_first": schema.StringAttribute{
+							MarkdownDescription: "Author name with last name first.",
+							Computed:            true,
+						},
+						"disambiguation": schema.StringAttribute{
+							MarkdownDescription: "Disambiguation.",
+							Computed:            true,
+						},
+						"title_slug": schema.StringAttribute{
+							MarkdownDescription: "Title slug.",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "Author status.",
+							Computed:            true,
+						},
+						"overview": schema.StringAttribute{
+							MarkdownDescription: "Overview.",
+							Computed:            true,
+						},
+						"genres": schema.SetAttribute{
+							MarkdownDescription: "List genres.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"ratings": schema.SingleNestedAttribute{
+							MarkdownDescription: "Ratings.",
+							Computed:            true,
+							Attributes:          ratingsDataSourceSchema(),
+						},
+						"images": schema.ListNestedAttribute{
+							MarkdownDescription: "Images.",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: mediaCoverDataSourceSchema(),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func ratingsDataSourceSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"votes": schema.Int64Attribute{
+			MarkdownDescription: "Votes.",
+			Computed:            true,
+		},
+		"value": schema.Float64Attribute{
+			MarkdownDescription: "Value.",
+			Computed:            true,
+		},
+		"popularity": schema.Float64Attribute{
+			MarkdownDescription: "Popularity.",
+			Computed:            true,
+		},
+	}
+}
+
+func mediaCoverDataSourceSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"url": schema.StringAttribute{
+			MarkdownDescription: "URL.",
+			Computed:            true,
+		},
+		"cover_type": schema.StringAttribute{
+			MarkdownDescription: "Cover type.",
+			Computed:            true,
+		},
+		"extension": schema.StringAttribute{
+			MarkdownDescription: "Extension.",
+			Computed:            true,
+		},
+	}
+}
+
+func (d *AuthorLookupDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if client := helpers.DataSourceConfigure(ctx, req, resp); client != nil {
+		d.client = client
+	}
+}
+
+func (d *AuthorLookupDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *AuthorLookup
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get author lookup current value
+	response, err := lookupAuthors(ctx, d.client, data.Term.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, authorLookupDataSourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+authorLookupDataSourceName)
+
+	authors := make([]LookupAuthor, len(response))
+	for i := range response {
+		authors[i].write(ctx, &response[i], &resp.Diagnostics)
+	}
+
+	authorList, diags := types.ListValueFrom(ctx, LookupAuthor{}.getType(), authors)
+	resp.Diagnostics.Append(diags...)
+
+	data.Authors = authorList
+	data.ID = types.StringValue(strconv.Itoa(len(response)))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// lookupAuthors searches the metadata provider for the given term.
+// The endpoint response is not typed in the SDK, so the body is decoded here.
+func lookupAuthors(ctx context.Context, client *readarr.APIClient, term string) ([]readarr.AuthorResource, error) {
+	httpResp, err := client.AuthorLookupAPI.GetAuthorLookup(ctx).Term(term).Execute()
+	if err != nil {
+		return nil, err
+	}
+
+	defer httpResp.Body.Close()
+
+	var authors []readarr.AuthorResource
+	if err = json.NewDecoder(httpResp.Body).Decode(&authors); err != nil {
+		return nil, err
+	}
+
+	return authors, nil
+}
+
+func (a *LookupAuthor) write(ctx context.Context, author *readarr.AuthorResource, diags *diag.Diagnostics) {
+	var tempDiag diag.Diagnostics
+
+	a.ID = types.Int64Value(int64(author.GetId()))
+	a.ForeignAuthorID = types.StringValue(author.GetForeignAuthorId())
+	a.AuthorName = types.StringValue(author.GetAuthorName())
+	a.AuthorNameLastFirst = types.StringValue(author.GetAuthorNameLastFirst())
+	a.Disambiguation = types.StringValue(author.GetDisambiguation())
+	a.TitleSlug = types.StringValue(author.GetTitleSlug())
+	a.Status = types.StringValue(string(author.GetStatus()))
+	a.Overview = types.StringValue(author.GetOverview())
+	a.Genres, tempDiag = types.SetValueFrom(ctx, types.StringType, author.GetGenres())
+	diags.Append(tempDiag...)
+	a.Ratings = writeRatings(ctx, author.Ratings, diags)
+	a.Images = writeMediaCovers(ctx, author.GetImages(), diags)
+}
+
+func writeRatings(ctx context.Context, ratings *readarr.Ratings, diags *diag.Diagnostics) types.Object {
+	r := Ratings{
+		Votes:      types.Int64Value(int64(ratings.GetVotes())),
+		Value:      types.Float64Value(ratings.GetValue()),
+		Popularity: types.Float64Value(ratings.GetPopularity()),
+	}
+
+	object, tempDiag := types.ObjectValueFrom(ctx, r.getType().(attr.TypeWithAttributeTypes).AttributeTypes(), r)
+	diags.Append(tempDiag...)
+
+	return object
+}
+
+func writeMediaCovers(ctx context.Context, covers []readarr.MediaCover, diags *diag.Diagnostics) types.List {
+	images := make([]MediaCover, len(covers))
+	for i, c := range covers {
+		images[i].URL = types.StringValue(c.GetUrl())
+		images[i].CoverType = types.StringValue(string(c.GetCoverType()))
+		images[i].Extension = types.StringValue(c.GetExtension())
+	}
+
+	list, tempDiag := types.ListValueFrom(ctx, MediaCover{}.getType(), images)
+	diags.Append(tempDiag...)
+
+	return list
+}
@@ -0,0 +1,40 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccAuthorLookupDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized
+			{
+				Config:      testAccAuthorLookupDataSourceConfig("Error") + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Read testing
+			{
+				Config: testAccAuthorLookupDataSourceConfig("readarr:656983"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("data.readarr_author_lookup.test", "authors.*", map[string]string{"foreign_author_id": "656983"}),
+				),
+			},
+		},
+	})
+}
+
+func testAccAuthorLookupDataSourceConfig(term string) string {
+	return fmt.Sprintf(`
+	data "readarr_author_lookup" "test" {
+		term = "%s"
+	}
+	`, term)
+}
@@ -39,24 +39,25 @@ type AuthorResource struct {
 type Author struct {
 	Genres           types.Set    `tfsdk:"genres"`
 	Tags             types.Set    `tfsdk:"tags"`
+	Links            types.Set    `tfsdk:"links"`
+	Ratings          types.Object `tfsdk:"ratings"`
+	Statistics       types.Object `tfsdk:"statistics"`
 	AuthorName       types.String `tfsdk:"author_name"`
 	ForeignAuthorID  types.String `tfsdk:"foreign_author_id"`
 	Status           types.String `tfsdk:"status"`
 	Path             types.String `tfsdk:"path"`
 	Overview         types.String `tfsdk:"overview"`
+	SortName         types.String `tfsdk:"sort_name"`
+	CleanName        types.String `tfsdk:"clean_name"`
+	Added            types.String `tfsdk:"added"`
 	ID               types.Int64  `tfsdk:"id"`
 	QualityProfileID types.Int64  `tfsdk:"quality_profile_id"`
 	Monitored        types.Bool   `tfsdk:"monitored"`
+	Ended            types.Bool   `tfsdk:"ended"`
 
 	// TODO: future Implementation
-	// Links          types.Set    `tfsdk:"links"`
-	// SortName       types.String `tfsdk:"sortName"`
-	// Ended          types.Bool   `tfsdk:"ended"`
 	// RootFolderPath types.String `tfsdk:"root_folder_path"`
 	// FolderName     types.String `tfsdk:"folderName"`
-	// CleanName      types.String `tfsdk:"cleanName"`
-	// Added          types.String `tfsdk:"added"`
-	// Ratings        types.Object `tfsdk:"ratings"`
 }
 
 func (a Author) getType() attr.Type {
@@ -64,14 +65,55 @@ func (a Author) getType() attr.Type {
 		map[string]attr.Type{
 			"genres":             types.SetType{}.WithElementType(types.StringType),
 			"tags":               types.SetType{}.WithElementType(types.Int64Type),
+			"links":              types.SetType{}.WithElementType(Link{}.getType()),
+			"ratings":            Ratings{}.getType(),
+			"statistics":         AuthorStatistics{}.getType(),
 			"author_name":        types.StringType,
 			"foreign_author_id":  types.StringType,
 			"status":             types.StringType,
 			"path":               types.StringType,
 			"overview":           types.StringType,
+			"sort_name":          types.StringType,
+			"clean_name":         types.StringType,
+			"added":              types.StringType,
 			"id":                 types.Int64Type,
 			"quality_profile_id": types.Int64Type,
 			"monitored":          types.BoolType,
+			"ended":              types.BoolType,
+		})
+}
+
+// Link is part of Author.
+type Link struct {
+	URL  types.String `tfsdk:"url"`
+	Name types.String `tfsdk:"name"`
+}
+
+func (l Link) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"url":  types.StringType,
+			"name": types.StringType,
+		})
+}
+
+// AuthorStatistics is part of Author.
+type AuthorStatistics struct {
+	PercentOfBooks types.Float64 `tfsdk:"percent_of_books"`
+	BookCount      types.Int64   `tfsdk:"book_count"`
+	TotalBookCount types.Int64   `tfsdk:"total_book_count"`
+	BookFileCount  types.Int64   `tfsdk:"book_file_count"`
+	SizeOnDisk     types.Int64   `tfsdk:"size_on_disk"`
+}
+
+func (s AuthorStatistics) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"percent_of_books": types.Float64Type,
+			"book_count":       types.Int64Type,
+			"total_book_count": types.Int64Type,
+			"book_file_count":  types.Int64Type,
+			"size_on_disk":     types.Int64Type,
 		})
 }
 
@@ -129,6 +171,82 @@ func (r *AuthorResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 				Computed:            true,
 				ElementType:         types.StringType,
 			},
+			"sort_name": schema.StringAttribute{
+				MarkdownDescription: "Sort name.",
+				Computed:            true,
+			},
+			"clean_name": schema.StringAttribute{
+				MarkdownDescription: "Clean name.",
+				Computed:            true,
+			},
+			"added": schema.StringAttribute{
+				MarkdownDescription: "Date the author was added to the library.",
+				Computed:            true,
+			},
+			"ended": schema.BoolAttribute{
+				MarkdownDescription: "Ended flag.",
+				Computed:            true,
+			},
+			"links": schema.SetNestedAttribute{
+				MarkdownDescription: "External links.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"url": schema.StringAttribute{
+							MarkdownDescription: "Link URL.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Link name.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"ratings": schema.SingleNestedAttribute{
+				MarkdownDescription: "Ratings.",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"votes": schema.Int64Attribute{
+						MarkdownDescription: "Votes.",
+						Computed:            true,
+					},
+					"value": schema.Float64Attribute{
+						MarkdownDescription: "Value.",
+						Computed:            true,
+					},
+					"popularity": schema.Float64Attribute{
+						MarkdownDescription: "Popularity.",
+						Computed:            true,
+					},
+				},
+			},
+			"statistics": schema.SingleNestedAttribute{
+				MarkdownDescription: "Library statistics.",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"book_count": schema.Int64Attribute{
+						MarkdownDescription: "Monitored book count.",
+						Computed:            true,
+					},
+					"total_book_count": schema.Int64Attribute{
+						MarkdownDescription: "Total book count.",
+						Computed:            true,
+					},
+					"book_file_count": schema.Int64Attribute{
+						MarkdownDescription: "Book file count.",
+						Computed:            true,
+					},
+					"size_on_disk": schema.Int64Attribute{
+						MarkdownDescription: "Size on disk in bytes.",
+						Computed:            true,
+					},
+					"percent_of_books": schema.Float64Attribute{
+						MarkdownDescription: "Percent of books on disk.",
+						Computed:            true,
+					},
+				},
+			},
 		},
 	}
 }
@@ -261,6 +379,41 @@ func (a *Author) write(ctx context.Context, author *readarr.AuthorResource, diag
 	diags.Append(tempDiag...)
 	a.Genres, tempDiag = types.SetValueFrom(ctx, types.StringType, author.GetGenres())
 	diags.Append(tempDiag...)
+	a.SortName = types.StringValue(author.GetSortName())
+	a.CleanName = types.StringValue(author.GetCleanName())
+	a.Added = helpers.TimeValue(author.GetAdded())
+	a.Ended = types.BoolValue(author.GetEnded())
+	a.Ratings = writeRatings(ctx, author.Ratings, diags)
+	a.Links = writeLinks(ctx, author.GetLinks(), diags)
+	a.Statistics = writeAuthorStatistics(ctx, author.Statistics, diags)
+}
+
+func writeLinks(ctx context.Context, links []readarr.Links, diags *diag.Diagnostics) types.Set {
+	l := make([]Link, len(links))
+	for i, link := range links {
+		l[i].URL = types.StringValue(link.GetUrl())
+		l[i].Name = types.StringValue(link.GetName())
+	}
+
+	set, tempDiag := types.SetValueFrom(ctx, Link{}.getType(), l)
+	diags.Append(tempDiag...)
+
+	return set
+}
+
+func writeAuthorStatistics(ctx context.Context, statistics *readarr.AuthorStatisticsResource, diags *diag.Diagnostics) types.Object {
+	s := AuthorStatistics{
+		PercentOfBooks: types.Float64Value(statistics.GetPercentOfBooks()),
+		BookCount:      types.Int64Value(int64(statistics.GetBookCount())),
+		TotalBookCount: types.Int64Value(int64(statistics.GetTotalBookCount())),
+		BookFileCount:  types.Int64Value(int64(statistics.GetBookFileCount())),
+		SizeOnDisk:     types.Int64Value(statistics.GetSizeOnDisk()),
+	}
+
+	object, tempDiag := types.ObjectValueFrom(ctx, s.getType().(attr.TypeWithAttributeTypes).AttributeTypes(), s)
+	diags.Append(tempDiag...)
+
+	return object
 }
 
 func (a *Author) read(ctx context.Context, diags *diag.Diagnostics) *readarr.AuthorResource {
@@ -30,6 +30,8 @@ func TestAccAuthorResource(t *testing.T) {
 					resource.TestCheckResourceAttr("readarr_author.test", "author_name", "J.R.R. Tolkien"),
 					resource.TestCheckResourceAttr("readarr_author.test", "status", "continuing"),
 					resource.TestCheckResourceAttr("readarr_author.test", "monitored", "false"),
+					resource.TestCheckResourceAttrSet("readarr_author.test", "added"),
+					resource.TestCheckResourceAttrSet("readarr_author.test", "statistics.book_count"),
 				),
 			},
 			// Unauthorized Read
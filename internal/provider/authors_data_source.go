@@ -2,12 +2,17 @@ package provider
 
 import (
 	"context"
+	"regexp"
 	"strconv"
+	"strings"
 
 	"github.com/devopsarr/readarr-go/readarr"
 	"github.com/devopsarr/terraform-provider-readarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -28,8 +33,15 @@ type AuthorsDataSource struct {
 
 // Authors describes the authors data model.
 type Authors struct {
-	Authors types.Set    `tfsdk:"authors"`
-	ID      types.String `tfsdk:"id"`
+	Authors           types.Set    `tfsdk:"authors"`
+	Tags              types.Set    `tfsdk:"tags"`
+	ID                types.String `tfsdk:"id"`
+	Status            types.String `tfsdk:"status"`
+	PathPrefix        types.String `tfsdk:"path_prefix"`
+	NameRegex         types.String `tfsdk:"name_regex"`
+	QualityProfileID  types.Int64  `tfsdk:"quality_profile_id"`
+	MetadataProfileID types.Int64  `tfsdk:"metadata_profile_id"`
+	Monitored         types.Bool   `tfsdk:"monitored"`
 }
 
 func (d *AuthorsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -39,12 +51,44 @@ func (d *AuthorsDataSource) Metadata(_ context.Context, req datasource.MetadataR
 func (d *AuthorsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		// This description is used by the documentation generator and the language server.
-		MarkdownDescription: "<!-- subcategory:Authors -->List all available [Authors](../resources/author).",
+		MarkdownDescription: "<!-- subcategory:Authors -->List all available [Authors](../resources/author), optionally filtered.",
 		Attributes: map[string]schema.Attribute{
 			// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
 			"id": schema.StringAttribute{
 				Computed: true,
 			},
+			"monitored": schema.BoolAttribute{
+				MarkdownDescription: "Filter by monitored flag.",
+				Optional:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Filter by author status. Valid values are 'continuing' and 'ended'.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("continuing", "ended"),
+				},
+			},
+			"tags": schema.SetAttribute{
+				MarkdownDescription: "Filter by tags. Authors must have all of them.",
+				Optional:            true,
+				ElementType:         types.Int64Type,
+			},
+			"quality_profile_id": schema.Int64Attribute{
+				MarkdownDescription: "Filter by quality profile ID.",
+				Optional:            true,
+			},
+			"metadata_profile_id": schema.Int64Attribute{
+				MarkdownDescription: "Filter by metadata profile ID.",
+				Optional:            true,
+			},
+			"path_prefix": schema.StringAttribute{
+				MarkdownDescription: "Filter by author path prefix, e.g. the root folder path.",
+				Optional:            true,
+			},
+			"name_regex": schema.StringAttribute{
+				MarkdownDescription: "Filter by author name regular expression.",
+				Optional:            true,
+			},
 			"authors": schema.SetNestedAttribute{
 				MarkdownDescription: "Author list.",
 				Computed:            true,
@@ -92,6 +136,39 @@ func (d *AuthorsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest
 							Computed:            true,
 							ElementType:         types.StringType,
 						},
+						"sort_name": schema.StringAttribute{
+							MarkdownDescription: "Sort name.",
+							Computed:            true,
+						},
+						"clean_name": schema.StringAttribute{
+							MarkdownDescription: "Clean name.",
+							Computed:            true,
+						},
+						"added": schema.StringAttribute{
+							MarkdownDescription: "Date the author was added to the library.",
+							Computed:            true,
+						},
+						"ended": schema.BoolAttribute{
+							MarkdownDescription: "Ended flag.",
+							Computed:            true,
+						},
+						"links": schema.SetNestedAttribute{
+							MarkdownDescription: "External links.",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: linkDataSourceSchema(),
+							},
+						},
+						"ratings": schema.SingleNestedAttribute{
+							MarkdownDescription: "Ratings.",
+							Computed:            true,
+							Attributes:          ratingsDataSourceSchema(),
+						},
+						"statistics": schema.SingleNestedAttribute{
+							MarkdownDescription: "Library statistics.",
+							Computed:            true,
+							Attributes:          authorStatisticsDataSourceSchema(),
+						},
 					},
 				},
 			},
@@ -105,27 +182,86 @@ func (d *AuthorsDataSource) Configure(ctx context.Context, req datasource.Config
 	}
 }
 
-func (d *AuthorsDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+func (d *AuthorsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *Authors
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter := data.filter(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Get authors current value
 	response, _, err := d.client.AuthorAPI.ListAuthor(ctx).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.List, authorsDataSourceName, err))
+
 		return
 	}
 
 	tflog.Trace(ctx, "read "+authorsDataSourceName)
 
-	pointerResponse := make([]*readarr.AuthorResource, len(response))
-	for i := range response {
-		pointerResponse[i] = &response[i]
-	}
+	authors := make([]Author, 0, len(response))
 
-	authors := make([]Author, len(pointerResponse))
-	for i, m := range pointerResponse {
-		authors[i].write(ctx, m, &resp.Diagnostics)
+	for i := range response {
+		if filter(&response[i]) {
+			author := Author{}
+			author.write(ctx, &response[i], &resp.Diagnostics)
+			authors = append(authors, author)
+		}
 	}
 
 	authorList, diags := types.SetValueFrom(ctx, Author{}.getType(), authors)
 	resp.Diagnostics.Append(diags...)
-	resp.Diagnostics.Append(resp.State.Set(ctx, Authors{Authors: authorList, ID: types.StringValue(strconv.Itoa(len(response)))})...)
+
+	data.Authors = authorList
+	data.ID = types.StringValue(strconv.Itoa(len(authors)))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// filter builds a function returning true if the author matches all the optional filters.
+func (a *Authors) filter(ctx context.Context, diags *diag.Diagnostics) func(*readarr.AuthorResource) bool {
+	var (
+		tags []int32
+		name *regexp.Regexp
+		err  error
+	)
+
+	diags.Append(a.Tags.ElementsAs(ctx, &tags, true)...)
+
+	if !a.NameRegex.IsNull() {
+		if name, err = regexp.Compile(a.NameRegex.ValueString()); err != nil {
+			diags.AddError(helpers.DataSourceError, "Invalid name_regex: "+err.Error())
+		}
+	}
+
+	return func(author *readarr.AuthorResource) bool {
+		switch {
+		case !a.Monitored.IsNull() && author.GetMonitored() != a.Monitored.ValueBool(),
+			!a.Status.IsNull() && string(author.GetStatus()) != a.Status.ValueString(),
+			!a.QualityProfileID.IsNull() && int64(author.GetQualityProfileId()) != a.QualityProfileID.ValueInt64(),
+			!a.MetadataProfileID.IsNull() && int64(author.GetMetadataProfileId()) != a.MetadataProfileID.ValueInt64(),
+			!a.PathPrefix.IsNull() && !strings.HasPrefix(author.GetPath(), a.PathPrefix.ValueString()),
+			name != nil && !name.MatchString(author.GetAuthorName()):
+			return false
+		}
+
+		authorTags := make(map[int32]bool)
+		for _, t := range author.GetTags() {
+			authorTags[t] = true
+		}
+
+		for _, t := range tags {
+			if !authorTags[t] {
+				return false
+			}
+		}
+
+		return true
+	}
 }
@@ -24,6 +24,7 @@ func TestAccAuthorsDataSource(t *testing.T) {
 				Config: testAccAuthorResourceConfig("J.K. Rowling", "jkrowling", "1077326") + testAccAuthorsDataSourceConfig,
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckTypeSetElemNestedAttrs("data.readarr_authors.test", "authors.*", map[string]string{"author_name": "J.K. Rowling"}),
+					resource.TestCheckResourceAttr("data.readarr_authors.test", "authors.#", "1"),
 				),
 			},
 		},
@@ -32,6 +33,9 @@ func TestAccAuthorsDataSource(t *testing.T) {
 
 const testAccAuthorsDataSourceConfig = `
 data "readarr_authors" "test" {
+	monitored = false
+	name_regex = "^J\\.K\\."
+	path_prefix = "/config"
 	depends_on = [readarr_author.test]
 }
 `
@@ -0,0 +1,284 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"strconv"
+
+	"github.com/devopsarr/readarr-go/readarr"
+	"github.com/devopsarr/terraform-provider-readarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const backupResourceName = "backup"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &BackupResource{}
+
+func NewBackupResource() resource.Resource {
+	return &BackupResource{}
+}
+
+// BackupResource defines the backup implementation.
+type BackupResource struct {
+	client *readarr.APIClient
+}
+
+// Backup describes the backup data model.
+type Backup struct {
+	Triggers     types.Map    `tfsdk:"triggers"`
+	DownloadPath types.String `tfsdk:"download_path"`
+	Name         types.String `tfsdk:"name"`
+	Path         types.String `tfsdk:"path"`
+	Type         types.String `tfsdk:"type"`
+	Time         types.String `tfsdk:"time"`
+	ID           types.Int64  `tfsdk:"id"`
+	Size         types.Int64  `tfsdk:"size"`
+	Timeout      types.Int64  `tfsdk:"timeout"`
+}
+
+func (r *BackupResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + backupResourceName
+}
+
+func (r *BackupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "<!-- subcategory:System -->Backup resource.\nIt runs a manual backup on creation, waits for its completion and optionally downloads the archive locally. Any change to `triggers` or `download_path` runs a new backup.\nDestroying it deletes the backup from Readarr, the downloaded archive is kept.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Backup ID.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"download_path": schema.StringAttribute{
+				MarkdownDescription: "Local path where the backup archive is downloaded.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary map of values that, when changed, will run a new backup.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"timeout": schema.Int64Attribute{
+				MarkdownDescription: "Seconds to wait for the backup completion. Defaults to 600.",
+				Optional:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "File name.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "Download path, relative to the Readarr URL.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Backup type.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"size": schema.Int64Attribute{
+				MarkdownDescription: "Size in bytes.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"time": schema.StringAttribute{
+				MarkdownDescription: "Creation time.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *BackupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
+		r.client = client
+	}
+}
+
+func (r *BackupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var backup *Backup
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &backup)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Run backup command
+	runCommand(ctx, r.client, "Backup", nil, backup.Timeout, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, _, err := r.client.BackupAPI.ListSystemBackup(ctx).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, backupResourceName, err))
+
+		return
+	}
+
+	created := latestBackup(response)
+	if created == nil {
+		resp.Diagnostics.AddError(helpers.ResourceError, helpers.ParseNotFoundError(backupResourceName, "type", string(readarr.BACKUPTYPE_MANUAL)))
+
+		return
+	}
+
+	if !backup.DownloadPath.IsNull() {
+		r.download(ctx, created.GetPath(), backup.DownloadPath.ValueString(), &resp.Diagnostics)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	tflog.Trace(ctx, "created "+backupResourceName+": "+strconv.Itoa(int(created.GetId())))
+	// Generate resource state struct
+	backup.write(created)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &backup)...)
+}
+
+func (r *BackupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var backup *Backup
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &backup)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get backups current value
+	response, _, err := r.client.BackupAPI.ListSystemBackup(ctx).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, backupResourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+backupResourceName+": "+strconv.Itoa(int(backup.ID.ValueInt64())))
+
+	for i := range response {
+		if int64(response[i].GetId()) == backup.ID.ValueInt64() {
+			// Map response body to resource schema attribute
+			backup.write(&response[i])
+			resp.Diagnostics.Append(resp.State.Set(ctx, &backup)...)
+
+			return
+		}
+	}
+
+	// Backups are rotated by Readarr, a missing one is created again.
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *BackupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Only timeout can be updated in place, no need to run a new backup.
+	var backup *Backup
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &backup)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "updated "+backupResourceName+": "+strconv.Itoa(int(backup.ID.ValueInt64())))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &backup)...)
+}
+
+func (r *BackupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var backup *Backup
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &backup)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete backup current value
+	_, err := r.client.BackupAPI.DeleteSystemBackup(ctx, int32(backup.ID.ValueInt64())).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, backupResourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "deleted "+backupResourceName+": "+strconv.Itoa(int(backup.ID.ValueInt64())))
+	resp.State.RemoveResource(ctx)
+}
+
+// download saves the backup archive to the local file system.
+func (r *BackupResource) download(ctx context.Context, source, destination string, diags *diag.Diagnostics) {
+	file, err := os.Create(destination)
+	if err != nil {
+		diags.AddError(helpers.ResourceError, "Unable to create "+destination+": "+err.Error())
+
+		return
+	}
+
+	defer file.Close()
+
+	if err = sendRawRequest(ctx, r.client, "BackupAPIService.ListSystemBackup", http.MethodGet, source, nil, file); err != nil {
+		diags.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, backupResourceName, err))
+
+		_ = os.Remove(destination)
+	}
+}
+
+// latestBackup returns the most recent manual backup.
+func latestBackup(backups []readarr.BackupResource) *readarr.BackupResource {
+	var latest *readarr.BackupResource
+
+	for i := range backups {
+		if backups[i].GetType() != readarr.BACKUPTYPE_MANUAL {
+			continue
+		}
+
+		if latest == nil || backups[i].GetTime().After(latest.GetTime()) {
+			latest = &backups[i]
+		}
+	}
+
+	return latest
+}
+
+func (b *Backup) write(backup *readarr.BackupResource) {
+	b.ID = types.Int64Value(int64(backup.GetId()))
+	b.Name = types.StringValue(backup.GetName())
+	b.Path = types.StringValue(backup.GetPath())
+	b.Type = types.StringValue(string(backup.GetType()))
+	b.Size = types.Int64Value(backup.GetSize())
+	b.Time = helpers.TimeValue(backup.GetTime())
+}
@@ -0,0 +1,96 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/devopsarr/readarr-go/readarr"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccBackupResource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized Create
+			{
+				Config:      testAccBackupResourceConfig("first") + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Create and Read testing
+			{
+				Config: testAccBackupResourceConfig("first"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("readarr_backup.test", "id"),
+					resource.TestCheckResourceAttrSet("readarr_backup.test", "name"),
+					resource.TestCheckResourceAttr("readarr_backup.test", "type", "manual"),
+				),
+			},
+			// Trigger testing
+			{
+				Config: testAccBackupResourceConfig("second"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("readarr_backup.test", "triggers.run", "second"),
+					resource.TestCheckResourceAttr("readarr_backup.test", "type", "manual"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccBackupResourceConfig(trigger string) string {
+	return fmt.Sprintf(`
+		resource "readarr_backup" "test" {
+			timeout = 120
+			triggers = {
+				run = "%s"
+			}
+		}
+	`, trigger)
+}
+
+func TestLatestBackup(t *testing.T) {
+	t.Parallel()
+
+	older := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	newer := older.Add(time.Hour)
+
+	tests := map[string]struct {
+		backups  []readarr.BackupResource
+		expected int32
+	}{
+		"empty": {
+			backups:  []readarr.BackupResource{},
+			expected: 0,
+		},
+		"scheduled only": {
+			backups: []readarr.BackupResource{
+				{Id: readarr.PtrInt32(1), Type: readarr.BACKUPTYPE_SCHEDULED.Ptr(), Time: &newer},
+			},
+			expected: 0,
+		},
+		"newest manual": {
+			backups: []readarr.BackupResource{
+				{Id: readarr.PtrInt32(1), Type: readarr.BACKUPTYPE_MANUAL.Ptr(), Time: &older},
+				{Id: readarr.PtrInt32(2), Type: readarr.BACKUPTYPE_MANUAL.Ptr(), Time: &newer},
+				{Id: readarr.PtrInt32(3), Type: readarr.BACKUPTYPE_UPDATE.Ptr(), Time: &newer},
+			},
+			expected: 2,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, test.expected, latestBackup(test.backups).GetId())
+		})
+	}
+}
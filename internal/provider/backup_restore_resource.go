@@ -0,0 +1,251 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/devopsarr/readarr-go/readarr"
+	"github.com/devopsarr/terraform-provider-readarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const backupRestoreResourceName = "backup_restore"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &BackupRestoreResource{}
+
+func NewBackupRestoreResource() resource.Resource {
+	return &BackupRestoreResource{}
+}
+
+// BackupRestoreResource defines the backup restore implementation.
+type BackupRestoreResource struct {
+	client *readarr.APIClient
+}
+
+// BackupRestore describes the backup restore data model.
+type BackupRestore struct {
+	Triggers  types.Map    `tfsdk:"triggers"`
+	ID        types.String `tfsdk:"id"`
+	FilePath  types.String `tfsdk:"file_path"`
+	StartTime types.String `tfsdk:"start_time"`
+	BackupID  types.Int64  `tfsdk:"backup_id"`
+	Timeout   types.Int64  `tfsdk:"timeout"`
+}
+
+func (r *BackupRestoreResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + backupRestoreResourceName
+}
+
+func (r *BackupRestoreResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "<!-- subcategory:System -->Backup restore resource.\nIt restores a local backup archive or an existing [backup](../data-sources/backups) on creation, restarts Readarr and waits until it is back online. Any change to `file_path`, `backup_id` or `triggers` runs it again. Destroying it has no effect on Readarr.\nThe restored configuration includes the API key, which must match the one used by the provider.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Backup restore ID. Either the backup ID or the file name.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"file_path": schema.StringAttribute{
+				MarkdownDescription: "Local path of the backup archive to upload. Either `file_path` or `backup_id` must be set.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"backup_id": schema.Int64Attribute{
+				MarkdownDescription: "ID of an existing backup. Either `file_path` or `backup_id` must be set.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.ExactlyOneOf(path.MatchRoot("file_path")),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary map of values that, when changed, will run the restore again.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"timeout": schema.Int64Attribute{
+				MarkdownDescription: "Seconds to wait for Readarr to restart. Defaults to 600.",
+				Optional:            true,
+			},
+			"start_time": schema.StringAttribute{
+				MarkdownDescription: "Readarr start time after the restore.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *BackupRestoreResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
+		r.client = client
+	}
+}
+
+func (r *BackupRestoreResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var restore *BackupRestore
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &restore)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Restore backup
+	if restore.FilePath.IsNull() {
+		restore.ID = types.StringValue(strconv.Itoa(int(restore.BackupID.ValueInt64())))
+		_, err := r.client.BackupAPI.CreateSystemBackupRestoreById(ctx, int32(restore.BackupID.ValueInt64())).Execute()
+		if err != nil {
+			resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, backupRestoreResourceName, err))
+
+			return
+		}
+	} else {
+		restore.ID = types.StringValue(filepath.Base(restore.FilePath.ValueString()))
+		r.upload(ctx, restore.FilePath.ValueString(), &resp.Diagnostics)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Restart to load the restored database and wait for it
+	status, _, err := r.client.SystemAPI.GetSystemStatus(ctx).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, backupRestoreResourceName, err))
+
+		return
+	}
+
+	_, err = r.client.SystemAPI.CreateSystemRestart(ctx).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, backupRestoreResourceName, err))
+
+		return
+	}
+
+	status = waitRestart(ctx, r.client, status.GetStartTime(), restore.Timeout, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "created "+backupRestoreResourceName+": "+restore.ID.ValueString())
+	// Generate resource state struct
+	restore.StartTime = helpers.TimeValue(status.GetStartTime())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &restore)...)
+}
+
+func (r *BackupRestoreResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Restores cannot be read back, the state is kept as is.
+	var restore *BackupRestore
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &restore)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "read "+backupRestoreResourceName+": "+restore.ID.ValueString())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &restore)...)
+}
+
+func (r *BackupRestoreResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Only timeout can be updated in place, no need to restore again.
+	var restore *BackupRestore
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &restore)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "updated "+backupRestoreResourceName+": "+restore.ID.ValueString())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &restore)...)
+}
+
+func (r *BackupRestoreResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Restores cannot be reverted
+	tflog.Trace(ctx, "deleted "+backupRestoreResourceName)
+	resp.State.RemoveResource(ctx)
+}
+
+// upload sends a local backup archive to the restore endpoint.
+func (r *BackupRestoreResource) upload(ctx context.Context, source string, diags *diag.Diagnostics) {
+	file, err := os.Open(source)
+	if err != nil {
+		diags.AddError(helpers.ResourceError, "Unable to open "+source+": "+err.Error())
+
+		return
+	}
+
+	defer file.Close()
+
+	if err = sendRawUpload(ctx, r.client, "BackupAPIService.CreateSystemBackupRestoreUpload", "/api/v1/system/backup/restore/upload", filepath.Base(source), file, nil); err != nil {
+		diags.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, backupRestoreResourceName, err))
+	}
+}
+
+// waitRestart polls the system status until Readarr is back online with a start time after the previous one.
+// Errors are expected while the application is restarting, so only the last one is reported on timeout.
+func waitRestart(ctx context.Context, client *readarr.APIClient, since time.Time, timeout types.Int64, diags *diag.Diagnostics) *readarr.SystemResource {
+	seconds := int64(defaultCommandTimeout)
+	if !timeout.IsNull() {
+		seconds = timeout.ValueInt64()
+	}
+
+	deadline := time.Now().Add(time.Duration(seconds) * time.Second)
+
+	for {
+		status, _, err := client.SystemAPI.GetSystemStatus(ctx).Execute()
+		if err == nil && status.GetStartTime().After(since) {
+			return status
+		}
+
+		if time.Now().After(deadline) {
+			message := "Timeout waiting for Readarr restart"
+			if err != nil {
+				message += ", last error: " + err.Error()
+			}
+
+			diags.AddError(helpers.ResourceError, message)
+
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			diags.AddError(helpers.ResourceError, fmt.Sprintf("Interrupted waiting for Readarr restart: %s", ctx.Err()))
+
+			return nil
+		case <-time.After(commandPollInterval):
+		}
+	}
+}
@@ -0,0 +1,46 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccBackupRestoreResource(t *testing.T) { //nolint:paralleltest // restarting Readarr would break the other tests
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Invalid combination
+			{
+				Config:      testAccBackupRestoreResourceConfig("backup_id = 1\nfile_path = \"/tmp/backup.zip\""),
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+			// Missing file
+			{
+				Config:      testAccBackupRestoreResourceConfig("file_path = \"/tmp/missing_backup.zip\""),
+				ExpectError: regexp.MustCompile("Unable to open"),
+			},
+			// Create and Read testing
+			{
+				Config: testAccBackupResourceConfig("restore") + testAccBackupRestoreResourceConfig("backup_id = readarr_backup.test.id"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("readarr_backup_restore.test", "id", "readarr_backup.test", "id"),
+					resource.TestCheckResourceAttrSet("readarr_backup_restore.test", "start_time"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccBackupRestoreResourceConfig(source string) string {
+	return fmt.Sprintf(`
+		resource "readarr_backup_restore" "test" {
+			%s
+			timeout = 120
+		}
+	`, source)
+}
@@ -0,0 +1,38 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccBackupsDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized
+			{
+				Config:      testAccBackupsDataSourceConfig + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Read testing
+			{
+				Config: testAccBackupResourceConfig("datasource") + testAccBackupsDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.readarr_backups.test", "id"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.readarr_backups.test", "backups.*", map[string]string{"type": "manual"}),
+				),
+			},
+		},
+	})
+}
+
+const testAccBackupsDataSourceConfig = `
+data "readarr_backups" "test" {
+	depends_on = [readarr_backup.test]
+}
+`
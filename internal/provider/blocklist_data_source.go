@@ -0,0 +1,239 @@
+package provider
+
+import (
+	"context"
+	"regexp"
+	"strconv"
+
+	"github.com/devopsarr/readarr-go/readarr"
+	"github.com/devopsarr/terraform-provider-readarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const blocklistDataSourceName = "blocklist"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &BlocklistDataSource{}
+
+func NewBlocklistDataSource() datasource.DataSource {
+	return &BlocklistDataSource{}
+}
+
+// BlocklistDataSource defines the blocklist implementation.
+type BlocklistDataSource struct {
+	client *readarr.APIClient
+}
+
+// Blocklist describes the blocklist data model.
+type Blocklist struct {
+	Entries          types.Set    `tfsdk:"entries"`
+	ID               types.String `tfsdk:"id"`
+	Indexer          types.String `tfsdk:"indexer"`
+	SourceTitleRegex types.String `tfsdk:"source_title_regex"`
+	AuthorID         types.Int64  `tfsdk:"author_id"`
+}
+
+// BlocklistEntry is part of Blocklist.
+type BlocklistEntry struct {
+	BookIDs     types.Set    `tfsdk:"book_ids"`
+	SourceTitle types.String `tfsdk:"source_title"`
+	QualityName types.String `tfsdk:"quality_name"`
+	Protocol    types.String `tfsdk:"protocol"`
+	Indexer     types.String `tfsdk:"indexer"`
+	Message     types.String `tfsdk:"message"`
+	Date        types.String `tfsdk:"date"`
+	ID          types.Int64  `tfsdk:"id"`
+	AuthorID    types.Int64  `tfsdk:"author_id"`
+}
+
+func (b BlocklistEntry) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"book_ids":     types.SetType{}.WithElementType(types.Int64Type),
+			"source_title": types.StringType,
+			"quality_name": types.StringType,
+			"protocol":     types.StringType,
+			"indexer":      types.StringType,
+			"message":      types.StringType,
+			"date":         types.StringType,
+			"id":           types.Int64Type,
+			"author_id":    types.Int64Type,
+		})
+}
+
+func (d *BlocklistDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + blocklistDataSourceName
+}
+
+func (d *BlocklistDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "<!-- subcategory:Activity -->List blocklist entries, optionally filtered.",
+		Attributes: map[string]schema.Attribute{
+			// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"author_id": schema.Int64Attribute{
+				MarkdownDescription: "Filter by author ID.",
+				Optional:            true,
+			},
+			"indexer": schema.StringAttribute{
+				MarkdownDescription: "Filter by indexer name.",
+				Optional:            true,
+			},
+			"source_title_regex": schema.StringAttribute{
+				MarkdownDescription: "Filter by source title regular expression.",
+				Optional:            true,
+				Validators: []validator.String{
+					helpers.ValidRegex(),
+				},
+			},
+			"entries": schema.SetNestedAttribute{
+				MarkdownDescription: "Blocklist entry list.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							MarkdownDescription: "Blocklist entry ID.",
+							Computed:            true,
+						},
+						"author_id": schema.Int64Attribute{
+							MarkdownDescription: "Author ID.",
+							Computed:            true,
+						},
+						"book_ids": schema.SetAttribute{
+							MarkdownDescription: "Book IDs.",
+							Computed:            true,
+							ElementType:         types.Int64Type,
+						},
+						"source_title": schema.StringAttribute{
+							MarkdownDescription: "Source title.",
+							Computed:            true,
+						},
+						"quality_name": schema.StringAttribute{
+							MarkdownDescription: "Quality name.",
+							Computed:            true,
+						},
+						"protocol": schema.StringAttribute{
+							MarkdownDescription: "Download protocol.",
+							Computed:            true,
+						},
+						"indexer": schema.StringAttribute{
+							MarkdownDescription: "Indexer name.",
+							Computed:            true,
+						},
+						"message": schema.StringAttribute{
+							MarkdownDescription: "Blocklist message.",
+							Computed:            true,
+						},
+						"date": schema.StringAttribute{
+							MarkdownDescription: "Blocklist date.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *BlocklistDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if client := helpers.DataSourceConfigure(ctx, req, resp); client != nil {
+		d.client = client
+	}
+}
+
+func (d *BlocklistDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *Blocklist
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter, err := blocklistFilter(data.AuthorID, data.Indexer, data.SourceTitleRegex)
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.DataSourceError, "Invalid source_title_regex: "+err.Error())
+
+		return
+	}
+
+	// Get blocklist current value
+	response, err := listPaged[readarr.BlocklistResource](ctx, d.client, "BlocklistAPIService.GetBlocklist", "/api/v1/blocklist", nil)
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.List, blocklistDataSourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+blocklistDataSourceName)
+
+	entries := make([]BlocklistEntry, 0, len(response))
+
+	for i := range response {
+		if !filter(&response[i]) {
+			continue
+		}
+
+		entry := BlocklistEntry{}
+		entry.write(ctx, &response[i], &resp.Diagnostics)
+		entries = append(entries, entry)
+	}
+
+	entryList, diags := types.SetValueFrom(ctx, BlocklistEntry{}.getType(), entries)
+	resp.Diagnostics.Append(diags...)
+
+	data.Entries = entryList
+	data.ID = types.StringValue(strconv.Itoa(len(entries)))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// blocklistFilter returns a function matching the blocklist entries against the non null filters.
+func blocklistFilter(authorID types.Int64, indexer, sourceTitleRegex types.String) (func(*readarr.BlocklistResource) bool, error) {
+	var (
+		title *regexp.Regexp
+		err   error
+	)
+
+	if !sourceTitleRegex.IsNull() {
+		if title, err = regexp.Compile(sourceTitleRegex.ValueString()); err != nil {
+			return nil, err
+		}
+	}
+
+	return func(entry *readarr.BlocklistResource) bool {
+		switch {
+		case !authorID.IsNull() && int64(entry.GetAuthorId()) != authorID.ValueInt64(),
+			!indexer.IsNull() && entry.GetIndexer() != indexer.ValueString(),
+			title != nil && !title.MatchString(entry.GetSourceTitle()):
+			return false
+		}
+
+		return true
+	}, nil
+}
+
+func (b *BlocklistEntry) write(ctx context.Context, entry *readarr.BlocklistResource, diags *diag.Diagnostics) {
+	var tempDiag diag.Diagnostics
+
+	quality := entry.Quality.GetQuality()
+
+	b.ID = types.Int64Value(int64(entry.GetId()))
+	b.AuthorID = types.Int64Value(int64(entry.GetAuthorId()))
+	b.SourceTitle = types.StringValue(entry.GetSourceTitle())
+	b.QualityName = types.StringValue(quality.GetName())
+	b.Protocol = types.StringValue(string(entry.GetProtocol()))
+	b.Indexer = types.StringValue(entry.GetIndexer())
+	b.Message = types.StringValue(entry.GetMessage())
+	b.Date = helpers.TimeValue(entry.GetDate())
+	b.BookIDs, tempDiag = types.SetValueFrom(ctx, types.Int64Type, entry.GetBookIds())
+	diags.Append(tempDiag...)
+}
@@ -0,0 +1,107 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/devopsarr/readarr-go/readarr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccBlocklistDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized
+			{
+				Config:      testAccBlocklistDataSourceConfig(".*") + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Invalid regex
+			{
+				Config:      testAccBlocklistDataSourceConfig("("),
+				ExpectError: regexp.MustCompile("Invalid source_title_regex"),
+			},
+			// Read testing
+			{
+				Config: testAccBlocklistDataSourceConfig(".*"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.readarr_blocklist.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccBlocklistDataSourceConfig(regex string) string {
+	return fmt.Sprintf(`
+	data "readarr_blocklist" "test" {
+		source_title_regex = "%s"
+	}
+	`, regex)
+}
+
+func TestBlocklistFilter(t *testing.T) {
+	t.Parallel()
+
+	entry := readarr.BlocklistResource{
+		AuthorId:    readarr.PtrInt32(1),
+		Indexer:     *readarr.NewNullableString(readarr.PtrString("Indexer")),
+		SourceTitle: *readarr.NewNullableString(readarr.PtrString("Author - Book [EPUB]")),
+	}
+
+	tests := map[string]struct {
+		authorID types.Int64
+		indexer  types.String
+		regex    types.String
+		expected bool
+	}{
+		"empty": {
+			authorID: types.Int64Null(),
+			indexer:  types.StringNull(),
+			regex:    types.StringNull(),
+			expected: true,
+		},
+		"matching": {
+			authorID: types.Int64Value(1),
+			indexer:  types.StringValue("Indexer"),
+			regex:    types.StringValue(`\[EPUB\]$`),
+			expected: true,
+		},
+		"author": {
+			authorID: types.Int64Value(2),
+			indexer:  types.StringNull(),
+			regex:    types.StringNull(),
+			expected: false,
+		},
+		"indexer": {
+			authorID: types.Int64Null(),
+			indexer:  types.StringValue("Other"),
+			regex:    types.StringNull(),
+			expected: false,
+		},
+		"title": {
+			authorID: types.Int64Null(),
+			indexer:  types.StringNull(),
+			regex:    types.StringValue("MOBI"),
+			expected: false,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			filter, err := blocklistFilter(test.authorID, test.indexer, test.regex)
+			assert.Nil(t, err)
+			assert.Equal(t, test.expected, filter(&entry))
+		})
+	}
+}
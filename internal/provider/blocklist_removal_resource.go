@@ -0,0 +1,200 @@
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/devopsarr/readarr-go/readarr"
+	"github.com/devopsarr/terraform-provider-readarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const blocklistRemovalResourceName = "blocklist_removal"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &BlocklistRemovalResource{}
+
+func NewBlocklistRemovalResource() resource.Resource {
+	return &BlocklistRemovalResource{}
+}
+
+// BlocklistRemovalResource defines the blocklist removal implementation.
+type BlocklistRemovalResource struct {
+	client *readarr.APIClient
+}
+
+// BlocklistRemoval describes the blocklist removal data model.
+type BlocklistRemoval struct {
+	Triggers         types.Map    `tfsdk:"triggers"`
+	RemovedIDs       types.Set    `tfsdk:"removed_ids"`
+	ID               types.String `tfsdk:"id"`
+	Indexer          types.String `tfsdk:"indexer"`
+	SourceTitleRegex types.String `tfsdk:"source_title_regex"`
+	AuthorID         types.Int64  `tfsdk:"author_id"`
+}
+
+func (r *BlocklistRemovalResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + blocklistRemovalResourceName
+}
+
+func (r *BlocklistRemovalResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "<!-- subcategory:Activity -->Blocklist removal resource.\nIt removes every [blocklist](../data-sources/blocklist) entry matching all the given filters on creation. Any change runs it again. Destroying it has no effect on Readarr.\nEntries cannot be pre-seeded, since Readarr has no endpoint to add them: they are only created when a download fails or when a [queue removal](queue_removal) blocklists it.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Blocklist removal ID. Time of the run.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"author_id": schema.Int64Attribute{
+				MarkdownDescription: "Author ID. At least one filter must be set.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeastOneOf(path.MatchRoot("indexer"), path.MatchRoot("source_title_regex")),
+				},
+			},
+			"indexer": schema.StringAttribute{
+				MarkdownDescription: "Indexer name. At least one filter must be set.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"source_title_regex": schema.StringAttribute{
+				MarkdownDescription: "Source title regular expression. At least one filter must be set.",
+				Optional:            true,
+				Validators: []validator.String{
+					helpers.ValidRegex(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary map of values that, when changed, will run the removal again.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"removed_ids": schema.SetAttribute{
+				MarkdownDescription: "Removed blocklist entry IDs.",
+				Computed:            true,
+				ElementType:         types.Int64Type,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *BlocklistRemovalResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
+		r.client = client
+	}
+}
+
+func (r *BlocklistRemovalResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var removal *BlocklistRemoval
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &removal)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter, err := blocklistFilter(removal.AuthorID, removal.Indexer, removal.SourceTitleRegex)
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ResourceError, "Invalid source_title_regex: "+err.Error())
+
+		return
+	}
+
+	// Find matching entries
+	response, err := listPaged[readarr.BlocklistResource](ctx, r.client, "BlocklistAPIService.GetBlocklist", "/api/v1/blocklist", nil)
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, blocklistRemovalResourceName, err))
+
+		return
+	}
+
+	request := readarr.NewBlocklistBulkResource()
+	request.Ids = make([]int32, 0, len(response))
+
+	for i := range response {
+		if filter(&response[i]) {
+			request.Ids = append(request.Ids, response[i].GetId())
+		}
+	}
+
+	// Remove them, if any
+	if len(request.Ids) > 0 {
+		if _, err = r.client.BlocklistAPI.DeleteBlocklistBulk(ctx).BlocklistBulkResource(*request).Execute(); err != nil {
+			resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, blocklistRemovalResourceName, err))
+
+			return
+		}
+	}
+
+	removal.ID = types.StringValue(time.Now().UTC().Format(time.RFC3339Nano))
+	removedIDs, diags := types.SetValueFrom(ctx, types.Int64Type, request.Ids)
+	resp.Diagnostics.Append(diags...)
+	removal.RemovedIDs = removedIDs
+
+	tflog.Trace(ctx, "created "+blocklistRemovalResourceName+": "+removal.ID.ValueString())
+	// Generate resource state struct
+	resp.Diagnostics.Append(resp.State.Set(ctx, &removal)...)
+}
+
+func (r *BlocklistRemovalResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Removed entries are gone from the blocklist, the state is kept as is.
+	var removal *BlocklistRemoval
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &removal)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "read "+blocklistRemovalResourceName+": "+removal.ID.ValueString())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &removal)...)
+}
+
+func (r *BlocklistRemovalResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Every attribute requires replace, nothing to update in place.
+	var removal *BlocklistRemoval
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &removal)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "updated "+blocklistRemovalResourceName+": "+removal.ID.ValueString())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &removal)...)
+}
+
+func (r *BlocklistRemovalResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Removed entries cannot be restored
+	tflog.Trace(ctx, "deleted "+blocklistRemovalResourceName)
+	resp.State.RemoveResource(ctx)
+}
@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccBlocklistRemovalResource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Missing filter
+			{
+				Config:      `resource "readarr_blocklist_removal" "test" {}`,
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+			// Invalid regex
+			{
+				Config:      `resource "readarr_blocklist_removal" "test" { source_title_regex = "[(" }`,
+				ExpectError: regexp.MustCompile("Invalid Regular Expression"),
+			},
+			// Unauthorized Create
+			{
+				Config:      testAccBlocklistRemovalResourceConfig("first") + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Create and Read testing
+			{
+				Config: testAccBlocklistRemovalResourceConfig("first"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("readarr_blocklist_removal.test", "id"),
+					resource.TestCheckResourceAttr("readarr_blocklist_removal.test", "removed_ids.#", "0"),
+				),
+			},
+			// Trigger testing
+			{
+				Config: testAccBlocklistRemovalResourceConfig("second"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("readarr_blocklist_removal.test", "triggers.run", "second"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccBlocklistRemovalResourceConfig(trigger string) string {
+	return fmt.Sprintf(`
+		resource "readarr_blocklist_removal" "test" {
+			source_title_regex = "^terraform-acceptance-test$"
+			triggers = {
+				run = "%s"
+			}
+		}
+	`, trigger)
+}
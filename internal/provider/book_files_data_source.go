@@ -0,0 +1,213 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/devopsarr/readarr-go/readarr"
+	"github.com/devopsarr/terraform-provider-readarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const bookFilesDataSourceName = "book_files"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &BookFilesDataSource{}
+
+func NewBookFilesDataSource() datasource.DataSource {
+	return &BookFilesDataSource{}
+}
+
+// BookFilesDataSource defines the book files implementation.
+type BookFilesDataSource struct {
+	client *readarr.APIClient
+}
+
+// BookFiles describes the book files data model.
+type BookFiles struct {
+	BookFiles types.Set    `tfsdk:"book_files"`
+	ID        types.String `tfsdk:"id"`
+	AuthorID  types.Int64  `tfsdk:"author_id"`
+	BookID    types.Int64  `tfsdk:"book_id"`
+}
+
+// BookFile is part of BookFiles.
+type BookFile struct {
+	Path                types.String  `tfsdk:"path"`
+	QualityName         types.String  `tfsdk:"quality_name"`
+	DateAdded           types.String  `tfsdk:"date_added"`
+	AudioBitRate        types.String  `tfsdk:"audio_bit_rate"`
+	AudioCodec          types.String  `tfsdk:"audio_codec"`
+	AudioChannels       types.Float64 `tfsdk:"audio_channels"`
+	ID                  types.Int64   `tfsdk:"id"`
+	AuthorID            types.Int64   `tfsdk:"author_id"`
+	BookID              types.Int64   `tfsdk:"book_id"`
+	Size                types.Int64   `tfsdk:"size"`
+	QualityCutoffNotMet types.Bool    `tfsdk:"quality_cutoff_not_met"`
+}
+
+func (b BookFile) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"path":                   types.StringType,
+			"quality_name":           types.StringType,
+			"date_added":             types.StringType,
+			"audio_bit_rate":         types.StringType,
+			"audio_codec":            types.StringType,
+			"audio_channels":         types.Float64Type,
+			"id":                     types.Int64Type,
+			"author_id":              types.Int64Type,
+			"book_id":                types.Int64Type,
+			"size":                   types.Int64Type,
+			"quality_cutoff_not_met": types.BoolType,
+		})
+}
+
+func (d *BookFilesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + bookFilesDataSourceName
+}
+
+func (d *BookFilesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "<!-- subcategory:Books -->List the files on disk of an author or of a book.",
+		Attributes: map[string]schema.Attribute{
+			// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"author_id": schema.Int64Attribute{
+				MarkdownDescription: "Author ID. Either `author_id` or `book_id` must be set.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.ExactlyOneOf(path.MatchRoot("book_id")),
+				},
+			},
+			"book_id": schema.Int64Attribute{
+				MarkdownDescription: "Book ID. Either `author_id` or `book_id` must be set.",
+				Optional:            true,
+			},
+			"book_files": schema.SetNestedAttribute{
+				MarkdownDescription: "Book file list.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							MarkdownDescription: "Book file ID.",
+							Computed:            true,
+						},
+						"author_id": schema.Int64Attribute{
+							MarkdownDescription: "Author ID.",
+							Computed:            true,
+						},
+						"book_id": schema.Int64Attribute{
+							MarkdownDescription: "Book ID.",
+							Computed:            true,
+						},
+						"path": schema.StringAttribute{
+							MarkdownDescription: "Full path.",
+							Computed:            true,
+						},
+						"size": schema.Int64Attribute{
+							MarkdownDescription: "Size in bytes.",
+							Computed:            true,
+						},
+						"date_added": schema.StringAttribute{
+							MarkdownDescription: "Date added.",
+							Computed:            true,
+						},
+						"quality_name": schema.StringAttribute{
+							MarkdownDescription: "Quality name.",
+							Computed:            true,
+						},
+						"quality_cutoff_not_met": schema.BoolAttribute{
+							MarkdownDescription: "Quality cutoff not met flag.",
+							Computed:            true,
+						},
+						"audio_bit_rate": schema.StringAttribute{
+							MarkdownDescription: "Audio bit rate.",
+							Computed:            true,
+						},
+						"audio_channels": schema.Float64Attribute{
+							MarkdownDescription: "Audio channels.",
+							Computed:            true,
+						},
+						"audio_codec": schema.StringAttribute{
+							MarkdownDescription: "Audio codec.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *BookFilesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if client := helpers.DataSourceConfigure(ctx, req, resp); client != nil {
+		d.client = client
+	}
+}
+
+func (d *BookFilesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *BookFiles
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get book files current value
+	request := d.client.BookFileAPI.ListBookFile(ctx)
+	if data.AuthorID.IsNull() {
+		request = request.BookId([]int32{int32(data.BookID.ValueInt64())})
+	} else {
+		request = request.AuthorId(int32(data.AuthorID.ValueInt64()))
+	}
+
+	response, _, err := request.Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.List, bookFilesDataSourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+bookFilesDataSourceName)
+
+	files := make([]BookFile, len(response))
+	for i := range response {
+		files[i].write(&response[i])
+	}
+
+	fileList, diags := types.SetValueFrom(ctx, BookFile{}.getType(), files)
+	resp.Diagnostics.Append(diags...)
+
+	data.BookFiles = fileList
+	data.ID = types.StringValue(strconv.Itoa(len(response)))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (b *BookFile) write(file *readarr.BookFileResource) {
+	quality := file.Quality.GetQuality()
+	mediaInfo := file.GetMediaInfo()
+
+	b.ID = types.Int64Value(int64(file.GetId()))
+	b.AuthorID = types.Int64Value(int64(file.GetAuthorId()))
+	b.BookID = types.Int64Value(int64(file.GetBookId()))
+	b.Path = types.StringValue(file.GetPath())
+	b.Size = types.Int64Value(file.GetSize())
+	b.DateAdded = helpers.TimeValue(file.GetDateAdded())
+	b.QualityName = types.StringValue(quality.GetName())
+	b.QualityCutoffNotMet = types.BoolValue(file.GetQualityCutoffNotMet())
+	b.AudioBitRate = types.StringValue(mediaInfo.GetAudioBitRate())
+	b.AudioChannels = types.Float64Value(mediaInfo.GetAudioChannels())
+	b.AudioCodec = types.StringValue(mediaInfo.GetAudioCodec())
+}
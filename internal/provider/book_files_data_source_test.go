@@ -0,0 +1,46 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccBookFilesDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized
+			{
+				Config:      testAccBookFilesDataSourceConfig("author_id", "1") + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Missing filter
+			{
+				Config:      `data "readarr_book_files" "test" {}`,
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+			// Read testing
+			{
+				PreConfig: rootFolderDSInit,
+				Config:    testAccBookResourceConfig("5470", "true") + testAccBookFilesDataSourceConfig("book_id", "readarr_book.test.id"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.readarr_book_files.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccBookFilesDataSourceConfig(filter, id string) string {
+	return fmt.Sprintf(`
+	data "readarr_book_files" "test" {
+		%s = %s
+	}
+	`, filter, id)
+}
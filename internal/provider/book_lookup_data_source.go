@@ -0,0 +1,235 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"github.com/devopsarr/readarr-go/readarr"
+	"github.com/devopsarr/terraform-provider-readarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const bookLookupDataSourceName = "book_lookup"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &BookLookupDataSource{}
+
+func NewBookLookupDataSource() datasource.DataSource {
+	return &BookLookupDataSource{}
+}
+
+// BookLookupDataSource defines the book lookup implementation.
+type BookLookupDataSource struct {
+	client *readarr.APIClient
+}
+
+// BookLookup describes the book lookup data model.
+type BookLookup struct {
+	Books types.List   `tfsdk:"books"`
+	Term  types.String `tfsdk:"term"`
+	ID    types.String `tfsdk:"id"`
+}
+
+// LookupBook is part of BookLookup.
+type LookupBook struct {
+	Genres           types.Set    `tfsdk:"genres"`
+	Ratings          types.Object `tfsdk:"ratings"`
+	Title            types.String `tfsdk:"title"`
+	ForeignBookID    types.String `tfsdk:"foreign_book_id"`
+	ForeignEditionID types.String `tfsdk:"foreign_edition_id"`
+	AuthorName       types.String `tfsdk:"author_name"`
+	ForeignAuthorID  types.String `tfsdk:"foreign_author_id"`
+	ReleaseDate      types.String `tfsdk:"release_date"`
+	Overview         types.String `tfsdk:"overview"`
+	ID               types.Int64  `tfsdk:"id"`
+	PageCount        types.Int64  `tfsdk:"page_count"`
+}
+
+func (b LookupBook) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"genres":             types.SetType{}.WithElementType(types.StringType),
+			"ratings":            Ratings{}.getType(),
+			"title":              types.StringType,
+			"foreign_book_id":    types.StringType,
+			"foreign_edition_id": types.StringType,
+			"author_name":        types.StringType,
+			"foreign_author_id":  types.StringType,
+			"release_date":       types.StringType,
+			"overview":           types.StringType,
+			"id":                 types.Int64Type,
+			"page_count":         types.Int64Type,
+		})
+}
+
+func (d *BookLookupDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + bookLookupDataSourceName
+}
+
+func (d *BookLookupDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "<!-- subcategory:Books -->Search the metadata provider for books.",
+		Attributes: map[string]schema.Attribute{
+			// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"term": schema.StringAttribute{
+				MarkdownDescription: "Search term. It can be a title, an ISBN, an ASIN or an identifier prefixed by `isbn:`, `asin:`, `edition:` or `work:`.",
+				Required:            true,
+			},
+			"books": schema.ListNestedAttribute{
+				MarkdownDescription: "Matching book list.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							MarkdownDescription: "Book ID. Zero if the book is not in the library.",
+							Computed:            true,
+						},
+						"title": schema.StringAttribute{
+							MarkdownDescription: "Book title.",
+							Computed:            true,
+						},
+						"foreign_book_id": schema.StringAttribute{
+							MarkdownDescription: "Foreign book ID.",
+							Computed:            true,
+						},
+						"foreign_edition_id": schema.StringAttribute{
+							MarkdownDescription: "Foreign edition ID of the matching edition.",
+							Computed:            true,
+						},
+						"author_name": schema.StringAttribute{
+							MarkdownDescription: "Author name.",
+							Computed:            true,
+						},
+						"foreign_author_id": schema.StringAttribute{
+							MarkdownDescription: "Foreign author ID.",
+							Computed:            true,
+						},
+						"release_date": schema.StringAttribute{
+							MarkdownDescription: "Release date.",
+							Computed:            true,
+						},
+						"page_count": schema.Int64Attribute{
+							MarkdownDescription: "Page count.",
+							Computed:            true,
+						},
+						"overview": schema.StringAttribute{
+							MarkdownDescription: "Overview.",
+							Computed:            true,
+						},
+						"genres": schema.SetAttribute{
+							MarkdownDescription: "List genres.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"ratings": schema.SingleNestedAttribute{
+							MarkdownDescription: "Ratings.",
+							Computed:            true,
+							Attributes:          ratingsDataSourceSchema(),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *BookLookupDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if client := helpers.DataSourceConfigure(ctx, req, resp); client != nil {
+		d.client = client
+	}
+}
+
+func (d *BookLookupDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *BookLookup
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get book lookup current value
+	response, err := lookupBooks(ctx, d.client, data.Term.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, bookLookupDataSourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+bookLookupDataSourceName)
+
+	books := make([]LookupBook, len(response))
+	for i := range response {
+		books[i].write(ctx, &response[i], &resp.Diagnostics)
+	}
+
+	bookList, diags := types.ListValueFrom(ctx, LookupBook{}.getType(), books)
+	resp.Diagnostics.Append(diags...)
+
+	data.Books = bookList
+	data.ID = types.StringValue(strconv.Itoa(len(response)))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// lookupBooks searches the metadata provider for the given term.
+// As for lookupAuthors, the untyped response body is decoded manually.
+func lookupBooks(ctx context.Context, client *readarr.APIClient, term string) ([]readarr.BookResource, error) {
+	httpResp, err := client.BookLookupAPI.GetBookLookup(ctx).Term(term).Execute()
+	if err != nil {
+		return nil, err
+	}
+
+	defer httpResp.Body.Close()
+
+	var books []readarr.BookResource
+	if err = json.NewDecoder(httpResp.Body).Decode(&books); err != nil {
+		return nil, err
+	}
+
+	return books, nil
+}
+
+// selectedEdition returns the monitored edition of a book, falling back to the first one.
+func selectedEdition(book *readarr.BookResource) *readarr.EditionResource {
+	editions := book.GetEditions()
+	for i := range editions {
+		if editions[i].GetMonitored() {
+			return &editions[i]
+		}
+	}
+
+	if len(editions) > 0 {
+		return &editions[0]
+	}
+
+	return nil
+}
+
+func (b *LookupBook) write(ctx context.Context, book *readarr.BookResource, diags *diag.Diagnostics) {
+	var tempDiag diag.Diagnostics
+
+	author := book.GetAuthor()
+
+	b.ID = types.Int64Value(int64(book.GetId()))
+	b.Title = types.StringValue(book.GetTitle())
+	b.ForeignBookID = types.StringValue(book.GetForeignBookId())
+	b.ForeignEditionID = types.StringValue(selectedEdition(book).GetForeignEditionId())
+	b.AuthorName = types.StringValue(author.GetAuthorName())
+	b.ForeignAuthorID = types.StringValue(author.GetForeignAuthorId())
+	b.ReleaseDate = helpers.TimeValue(book.GetReleaseDate())
+	b.PageCount = types.Int64Value(int64(book.GetPageCount()))
+	b.Overview = types.StringValue(book.GetOverview())
+	b.Genres, tempDiag = types.SetValueFrom(ctx, types.StringType, book.GetGenres())
+	diags.Append(tempDiag...)
+	b.Ratings = writeRatings(ctx, book.Ratings, diags)
+}
@@ -0,0 +1,41 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccBookLookupDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized
+			{
+				Config:      testAccBookLookupDataSourceConfig("Error") + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Read testing
+			{
+				Config: testAccBookLookupDataSourceConfig("isbn:9780261103573"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.readarr_book_lookup.test", "books.0.foreign_book_id"),
+					resource.TestCheckResourceAttrSet("data.readarr_book_lookup.test", "books.0.foreign_author_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccBookLookupDataSourceConfig(term string) string {
+	return fmt.Sprintf(`
+	data "readarr_book_lookup" "test" {
+		term = "%s"
+	}
+	`, term)
+}
@@ -0,0 +1,402 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/devopsarr/readarr-go/readarr"
+	"github.com/devopsarr/terraform-provider-readarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const bookResourceName = "book"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &BookResource{}
+	_ resource.ResourceWithImportState = &BookResource{}
+)
+
+func NewBookResource() resource.Resource {
+	return &BookResource{}
+}
+
+// BookResource defines the book implementation.
+type BookResource struct {
+	client *readarr.APIClient
+}
+
+// Book describes the book data model.
+type Book struct {
+	ForeignBookID     types.String `tfsdk:"foreign_book_id"`
+	ForeignEditionID  types.String `tfsdk:"foreign_edition_id"`
+	ForeignAuthorID   types.String `tfsdk:"foreign_author_id"`
+	Title             types.String `tfsdk:"title"`
+	RootFolderPath    types.String `tfsdk:"root_folder_path"`
+	ID                types.Int64  `tfsdk:"id"`
+	AuthorID          types.Int64  `tfsdk:"author_id"`
+	QualityProfileID  types.Int64  `tfsdk:"quality_profile_id"`
+	MetadataProfileID types.Int64  `tfsdk:"metadata_profile_id"`
+	Monitored         types.Bool   `tfsdk:"monitored"`
+	AnyEditionOk      types.Bool   `tfsdk:"any_edition_ok"`
+	UnmonitorOnDelete types.Bool   `tfsdk:"unmonitor_on_delete"`
+}
+
+func (r *BookResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + bookResourceName
+}
+
+func (r *BookResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "<!-- subcategory:Books -->Book resource. If the book author is not in the library yet, it is added unmonitored together with the book.\nFor more information refer to [Books](https://wiki.servarr.com/readarr/library#books) documentation.",
+		Attributes: map[string]schema.Attribute{
+			"monitored": schema.BoolAttribute{
+				MarkdownDescription: "Monitored flag.",
+				Required:            true,
+			},
+			"any_edition_ok": schema.BoolAttribute{
+				MarkdownDescription: "Any edition OK flag.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"unmonitor_on_delete": schema.BoolAttribute{
+				MarkdownDescription: "Unmonitor the book on destroy instead of removing it from the library.",
+				Optional:            true,
+			},
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Book ID.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"foreign_book_id": schema.StringAttribute{
+				MarkdownDescription: "Foreign book ID.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"foreign_edition_id": schema.StringAttribute{
+				MarkdownDescription: "Foreign edition ID of the selected edition. If set, the edition is pinned as the only monitored one.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"title": schema.StringAttribute{
+				MarkdownDescription: "Book title.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"author_id": schema.Int64Attribute{
+				MarkdownDescription: "Author ID.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"foreign_author_id": schema.StringAttribute{
+				MarkdownDescription: "Foreign author ID.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"quality_profile_id": schema.Int64Attribute{
+				MarkdownDescription: "Quality profile ID. Required when the author needs to be added, ignored otherwise.",
+				Optional:            true,
+			},
+			"metadata_profile_id": schema.Int64Attribute{
+				MarkdownDescription: "Metadata profile ID. Required when the author needs to be added, ignored otherwise.",
+				Optional:            true,
+			},
+			"root_folder_path": schema.StringAttribute{
+				MarkdownDescription: "Root folder path. Required when the author needs to be added, ignored otherwise.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *BookResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
+		r.client = client
+	}
+}
+
+func (r *BookResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var book *Book
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &book)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Search book on metadata provider
+	lookup, err := lookupBooks(ctx, r.client, "work:"+book.ForeignBookID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, bookResourceName, err))
+
+		return
+	}
+
+	request := book.find(lookup, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var response *readarr.BookResource
+
+	if request.GetId() != 0 {
+		// Book already in library, just align its flags
+		book.ID = types.Int64Value(int64(request.GetId()))
+		response = r.update(ctx, book, &resp.Diagnostics)
+	} else {
+		// Create new Book
+		book.read(request, &resp.Diagnostics)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		response, _, err = r.client.BookAPI.CreateBook(ctx).BookResource(*request).Execute()
+		if err != nil {
+			resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, bookResourceName, err))
+		}
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "created "+bookResourceName+": "+strconv.Itoa(int(response.GetId())))
+	// Generate resource state struct
+	book.write(response)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &book)...)
+}
+
+func (r *BookResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var book *Book
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &book)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get book current value
+	response, err := r.get(ctx, book.ID.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, bookResourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+bookResourceName+": "+strconv.Itoa(int(response.GetId())))
+	// Map response body to resource schema attribute
+	book.write(response)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &book)...)
+}
+
+func (r *BookResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Get plan values
+	var book *Book
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &book)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update Book
+	response := r.update(ctx, book, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "updated "+bookResourceName+": "+strconv.Itoa(int(response.GetId())))
+	// Generate resource state struct
+	book.write(response)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &book)...)
+}
+
+func (r *BookResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var book *Book
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &book)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var err error
+
+	// Delete book current value
+	if book.UnmonitorOnDelete.ValueBool() {
+		monitor := readarr.NewBooksMonitoredResource()
+		monitor.SetBookIds([]int32{int32(book.ID.ValueInt64())})
+		monitor.SetMonitored(false)
+
+		_, err = r.client.BookAPI.PutBookMonitor(ctx).BooksMonitoredResource(*monitor).Execute()
+	} else {
+		_, err = r.client.BookAPI.DeleteBook(ctx, int32(book.ID.ValueInt64())).Execute()
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, bookResourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "deleted "+bookResourceName+": "+strconv.Itoa(int(book.ID.ValueInt64())))
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *BookResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	tflog.Trace(ctx, "imported "+bookResourceName+": "+req.ID)
+}
+
+// get retrieves a book together with its editions.
+func (r *BookResource) get(ctx context.Context, id int64) (*readarr.BookResource, error) {
+	book, _, err := r.client.BookAPI.GetBookById(ctx, int32(id)).Execute()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(book.GetEditions()) == 0 {
+		editions, _, editionErr := r.client.EditionAPI.ListEdition(ctx).BookId([]int32{book.GetId()}).Execute()
+		if editionErr != nil {
+			return nil, editionErr
+		}
+
+		book.SetEditions(editions)
+	}
+
+	return book, nil
+}
+
+// update retrieves the full book, since the API expects editions to be sent back, and applies the plan flags.
+func (r *BookResource) update(ctx context.Context, book *Book, diags *diag.Diagnostics) *readarr.BookResource {
+	request, err := r.get(ctx, book.ID.ValueInt64())
+	if err != nil {
+		diags.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, bookResourceName, err))
+
+		return nil
+	}
+
+	book.read(request, diags)
+
+	if diags.HasError() {
+		return nil
+	}
+
+	response, _, err := r.client.BookAPI.UpdateBook(ctx, strconv.Itoa(int(request.GetId()))).BookResource(*request).Execute()
+	if err != nil {
+		diags.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, bookResourceName, err))
+
+		return nil
+	}
+
+	return response
+}
+
+func (b *Book) find(books []readarr.BookResource, diags *diag.Diagnostics) *readarr.BookResource {
+	for i := range books {
+		if books[i].GetForeignBookId() == b.ForeignBookID.ValueString() {
+			return &books[i]
+		}
+	}
+
+	diags.AddError(helpers.ResourceError, helpers.ParseNotFoundError(bookResourceName, "foreign book ID", b.ForeignBookID.ValueString()))
+
+	return nil
+}
+
+// pinEdition monitors the planned edition and unmonitors all the others.
+func (b *Book) pinEdition(book *readarr.BookResource, diags *diag.Diagnostics) {
+	found := false
+	editions := book.GetEditions()
+
+	for i := range editions {
+		pinned := editions[i].GetForeignEditionId() == b.ForeignEditionID.ValueString()
+		editions[i].SetMonitored(pinned)
+		found = found || pinned
+	}
+
+	if !found {
+		diags.AddError(helpers.ResourceError, helpers.ParseNotFoundError(bookResourceName, "foreign edition ID", b.ForeignEditionID.ValueString()))
+	}
+}
+
+func (b *Book) write(book *readarr.BookResource) {
+	b.ID = types.Int64Value(int64(book.GetId()))
+	b.Monitored = types.BoolValue(book.GetMonitored())
+	b.AnyEditionOk = types.BoolValue(book.GetAnyEditionOk())
+	b.ForeignBookID = types.StringValue(book.GetForeignBookId())
+	b.ForeignEditionID = types.StringValue(selectedEdition(book).GetForeignEditionId())
+	b.Title = types.StringValue(book.GetTitle())
+	b.AuthorID = types.Int64Value(int64(book.GetAuthorId()))
+	author := book.GetAuthor()
+	b.ForeignAuthorID = types.StringValue(author.GetForeignAuthorId())
+}
+
+// read applies the plan to a book resource retrieved from the API.
+func (b *Book) read(book *readarr.BookResource, diags *diag.Diagnostics) {
+	book.SetMonitored(b.Monitored.ValueBool())
+
+	if !b.AnyEditionOk.IsNull() && !b.AnyEditionOk.IsUnknown() {
+		book.SetAnyEditionOk(b.AnyEditionOk.ValueBool())
+	}
+
+	if !b.ForeignEditionID.IsNull() && !b.ForeignEditionID.IsUnknown() {
+		b.pinEdition(book, diags)
+	}
+
+	if book.GetId() != 0 {
+		return
+	}
+
+	// New book: make sure an edition is selected and prepare the author in case it is missing.
+	if edition := selectedEdition(book); edition != nil {
+		edition.SetMonitored(true)
+	}
+
+	options := readarr.NewAddBookOptions()
+	options.SetSearchForNewBook(false)
+	book.SetAddOptions(*options)
+
+	author := book.GetAuthor()
+	if author.GetId() == 0 {
+		if b.QualityProfileID.IsNull() || b.MetadataProfileID.IsNull() || b.RootFolderPath.IsNull() {
+			diags.AddError(helpers.ResourceError, "Author "+author.GetAuthorName()+" is not in the library yet, quality_profile_id, metadata_profile_id and root_folder_path are required to add it")
+
+			return
+		}
+
+		authorOptions := readarr.NewAddAuthorOptions()
+		authorOptions.SetMonitor(readarr.MONITORTYPES_NONE)
+		authorOptions.SetSearchForMissingBooks(false)
+		author.SetAddOptions(*authorOptions)
+		author.SetMonitored(false)
+		author.SetQualityProfileId(int32(b.QualityProfileID.ValueInt64()))
+		author.SetMetadataProfileId(int32(b.MetadataProfileID.ValueInt64()))
+		author.SetRootFolderPath(b.RootFolderPath.ValueString())
+		book.SetAuthor(author)
+	}
+}
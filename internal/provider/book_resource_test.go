@@ -0,0 +1,145 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/devopsarr/readarr-go/readarr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccBookResource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized Create
+			{
+				Config:      testAccBookResourceConfig("1885", "false") + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Create and Read testing
+			{
+				PreConfig: rootFolderDSInit,
+				Config:    testAccBookResourceConfig("1885", "false"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("readarr_book.test", "id"),
+					resource.TestCheckResourceAttrSet("readarr_book.test", "author_id"),
+					resource.TestCheckResourceAttr("readarr_book.test", "foreign_author_id", "1265"),
+					resource.TestCheckResourceAttr("readarr_book.test", "monitored", "false"),
+				),
+			},
+			// Unauthorized Read
+			{
+				Config:      testAccBookResourceConfig("1885", "false") + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Update and Read testing
+			{
+				Config: testAccBookResourceConfig("1885", "true"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("readarr_book.test", "monitored", "true"),
+				),
+			},
+			// Pin edition testing
+			{
+				Config: testAccBookResourceEditionConfig("isbn:9780141439518"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("readarr_book.test", "foreign_edition_id", "data.readarr_book_lookup.test", "books.0.foreign_edition_id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "readarr_book.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"quality_profile_id", "metadata_profile_id", "root_folder_path"},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccBookResourceConfig(foreignID, monitored string) string {
+	return fmt.Sprintf(`
+		resource "readarr_book" "test" {
+			foreign_book_id = "%s"
+			monitored = %s
+			quality_profile_id = 1
+			metadata_profile_id = 1
+			root_folder_path = "/config"
+		}
+	`, foreignID, monitored)
+}
+
+func testAccBookResourceEditionConfig(term string) string {
+	return fmt.Sprintf(`
+		data "readarr_book_lookup" "test" {
+			term = "%s"
+		}
+
+		resource "readarr_book" "test" {
+			foreign_book_id = "1885"
+			foreign_edition_id = data.readarr_book_lookup.test.books[0].foreign_edition_id
+			monitored = true
+			quality_profile_id = 1
+			metadata_profile_id = 1
+			root_folder_path = "/config"
+		}
+	`, term)
+}
+
+func TestBookReadNewAuthor(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		book  Book
+		error bool
+	}{
+		"missing profiles": {
+			book: Book{
+				QualityProfileID:  types.Int64Null(),
+				MetadataProfileID: types.Int64Null(),
+				RootFolderPath:    types.StringValue("/config"),
+			},
+			error: true,
+		},
+		"complete": {
+			book: Book{
+				QualityProfileID:  types.Int64Value(1),
+				MetadataProfileID: types.Int64Value(1),
+				RootFolderPath:    types.StringValue("/config"),
+			},
+			error: false,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			author := readarr.NewAuthorResource()
+			author.SetAuthorName("Jane Austen")
+
+			request := readarr.NewBookResource()
+			request.SetAuthor(*author)
+
+			var diags diag.Diagnostics
+
+			test.book.read(request, &diags)
+			assert.Equal(t, test.error, diags.HasError())
+
+			if !test.error {
+				result := request.GetAuthor()
+				assert.Equal(t, "/config", result.GetRootFolderPath())
+			}
+		})
+	}
+}
@@ -0,0 +1,245 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/devopsarr/readarr-go/readarr"
+	"github.com/devopsarr/terraform-provider-readarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const booksDataSourceName = "books"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &BooksDataSource{}
+
+func NewBooksDataSource() datasource.DataSource {
+	return &BooksDataSource{}
+}
+
+// BooksDataSource defines the books implementation.
+type BooksDataSource struct {
+	client *readarr.APIClient
+}
+
+// Books describes the books data model.
+type Books struct {
+	Books     types.Set    `tfsdk:"books"`
+	ID        types.String `tfsdk:"id"`
+	AuthorID  types.Int64  `tfsdk:"author_id"`
+	Monitored types.Bool   `tfsdk:"monitored"`
+	Released  types.Bool   `tfsdk:"released"`
+}
+
+// BookSummary is part of Books.
+type BookSummary struct {
+	Title            types.String  `tfsdk:"title"`
+	ForeignBookID    types.String  `tfsdk:"foreign_book_id"`
+	ForeignEditionID types.String  `tfsdk:"foreign_edition_id"`
+	ReleaseDate      types.String  `tfsdk:"release_date"`
+	PercentOfBooks   types.Float64 `tfsdk:"percent_of_books"`
+	ID               types.Int64   `tfsdk:"id"`
+	EditionCount     types.Int64   `tfsdk:"edition_count"`
+	BookFileCount    types.Int64   `tfsdk:"book_file_count"`
+	SizeOnDisk       types.Int64   `tfsdk:"size_on_disk"`
+	Monitored        types.Bool    `tfsdk:"monitored"`
+}
+
+func (b BookSummary) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"title":              types.StringType,
+			"foreign_book_id":    types.StringType,
+			"foreign_edition_id": types.StringType,
+			"release_date":       types.StringType,
+			"percent_of_books":   types.Float64Type,
+			"id":                 types.Int64Type,
+			"edition_count":      types.Int64Type,
+			"book_file_count":    types.Int64Type,
+			"size_on_disk":       types.Int64Type,
+			"monitored":          types.BoolType,
+		})
+}
+
+func (d *BooksDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + booksDataSourceName
+}
+
+func (d *BooksDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "<!-- subcategory:Books -->List all [Books](../resources/book) of an author.",
+		Attributes: map[string]schema.Attribute{
+			// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"author_id": schema.Int64Attribute{
+				MarkdownDescription: "Author ID.",
+				Required:            true,
+			},
+			"monitored": schema.BoolAttribute{
+				MarkdownDescription: "Filter by monitored flag.",
+				Optional:            true,
+			},
+			"released": schema.BoolAttribute{
+				MarkdownDescription: "Filter by release status. Books without a release date are considered not released.",
+				Optional:            true,
+			},
+			"books": schema.SetNestedAttribute{
+				MarkdownDescription: "Book list.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							MarkdownDescription: "Book ID.",
+							Computed:            true,
+						},
+						"title": schema.StringAttribute{
+							MarkdownDescription: "Book title.",
+							Computed:            true,
+						},
+						"foreign_book_id": schema.StringAttribute{
+							MarkdownDescription: "Foreign book ID.",
+							Computed:            true,
+						},
+						"foreign_edition_id": schema.StringAttribute{
+							MarkdownDescription: "Foreign edition ID of the selected edition.",
+							Computed:            true,
+						},
+						"release_date": schema.StringAttribute{
+							MarkdownDescription: "Release date.",
+							Computed:            true,
+						},
+						"monitored": schema.BoolAttribute{
+							MarkdownDescription: "Monitored flag.",
+							Computed:            true,
+						},
+						"edition_count": schema.Int64Attribute{
+							MarkdownDescription: "Edition count.",
+							Computed:            true,
+						},
+						"book_file_count": schema.Int64Attribute{
+							MarkdownDescription: "Book file count.",
+							Computed:            true,
+						},
+						"size_on_disk": schema.Int64Attribute{
+							MarkdownDescription: "Size on disk in bytes.",
+							Computed:            true,
+						},
+						"percent_of_books": schema.Float64Attribute{
+							MarkdownDescription: "Percent of books on disk.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *BooksDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if client := helpers.DataSourceConfigure(ctx, req, resp); client != nil {
+		d.client = client
+	}
+}
+
+func (d *BooksDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *Books
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get books current value
+	response, _, err := d.client.BookAPI.ListBook(ctx).AuthorId(int32(data.AuthorID.ValueInt64())).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.List, booksDataSourceName, err))
+
+		return
+	}
+
+	// Editions are not always part of the list response, they are retrieved separately
+	if len(response) > 0 {
+		ids := make([]int32, len(response))
+		for i := range response {
+			ids[i] = response[i].GetId()
+		}
+
+		editionResponse, _, editionErr := d.client.EditionAPI.ListEdition(ctx).BookId(ids).Execute()
+		if editionErr != nil {
+			resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.List, booksDataSourceName, editionErr))
+
+			return
+		}
+
+		editions := make(map[int32][]readarr.EditionResource)
+		for _, e := range editionResponse {
+			editions[e.GetBookId()] = append(editions[e.GetBookId()], e)
+		}
+
+		for i := range response {
+			response[i].SetEditions(editions[response[i].GetId()])
+		}
+	}
+
+	tflog.Trace(ctx, "read "+booksDataSourceName)
+
+	books := make([]BookSummary, 0, len(response))
+
+	for i := range response {
+		if data.filter(&response[i]) {
+			book := BookSummary{}
+			book.write(&response[i])
+			books = append(books, book)
+		}
+	}
+
+	bookList, diags := types.SetValueFrom(ctx, BookSummary{}.getType(), books)
+	resp.Diagnostics.Append(diags...)
+
+	data.Books = bookList
+	data.ID = types.StringValue(strconv.Itoa(len(books)))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// filter returns true if the book matches the optional filters.
+func (b *Books) filter(book *readarr.BookResource) bool {
+	if !b.Monitored.IsNull() && book.GetMonitored() != b.Monitored.ValueBool() {
+		return false
+	}
+
+	if !b.Released.IsNull() {
+		date := book.GetReleaseDate()
+		released := !date.IsZero() && date.Before(time.Now())
+
+		if released != b.Released.ValueBool() {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (b *BookSummary) write(book *readarr.BookResource) {
+	statistics := book.GetStatistics()
+
+	b.ID = types.Int64Value(int64(book.GetId()))
+	b.Title = types.StringValue(book.GetTitle())
+	b.ForeignBookID = types.StringValue(book.GetForeignBookId())
+	b.ForeignEditionID = types.StringValue(selectedEdition(book).GetForeignEditionId())
+	b.ReleaseDate = helpers.TimeValue(book.GetReleaseDate())
+	b.Monitored = types.BoolValue(book.GetMonitored())
+	b.EditionCount = types.Int64Value(int64(len(book.GetEditions())))
+	b.BookFileCount = types.Int64Value(int64(statistics.GetBookFileCount()))
+	b.SizeOnDisk = types.Int64Value(statistics.GetSizeOnDisk())
+	b.PercentOfBooks = types.Float64Value(statistics.GetPercentOfBooks())
+}
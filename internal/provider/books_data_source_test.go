@@ -0,0 +1,42 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccBooksDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized
+			{
+				Config:      testAccBooksDataSourceConfig("1") + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Read testing
+			{
+				PreConfig: rootFolderDSInit,
+				Config:    testAccBookResourceConfig("4671", "true") + testAccBooksDataSourceConfig("readarr_book.test.author_id"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("data.readarr_books.test", "books.*", map[string]string{"foreign_book_id": "4671", "monitored": "true"}),
+				),
+			},
+		},
+	})
+}
+
+func testAccBooksDataSourceConfig(authorID string) string {
+	return fmt.Sprintf(`
+	data "readarr_books" "test" {
+		author_id = %s
+		monitored = true
+	}
+	`, authorID)
+}
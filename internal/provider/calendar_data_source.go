@@ -0,0 +1,168 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/devopsarr/readarr-go/readarr"
+	"github.com/devopsarr/terraform-provider-readarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const calendarDataSourceName = "calendar"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &CalendarDataSource{}
+
+func NewCalendarDataSource() datasource.DataSource {
+	return &CalendarDataSource{}
+}
+
+// CalendarDataSource defines the calendar implementation.
+type CalendarDataSource struct {
+	client *readarr.APIClient
+}
+
+// Calendar describes the calendar data model.
+type Calendar struct {
+	Books              types.Set    `tfsdk:"books"`
+	Start              types.String `tfsdk:"start"`
+	End                types.String `tfsdk:"end"`
+	ID                 types.String `tfsdk:"id"`
+	IncludeUnmonitored types.Bool   `tfsdk:"include_unmonitored"`
+}
+
+func (d *CalendarDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + calendarDataSourceName
+}
+
+func (d *CalendarDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "<!-- subcategory:Wanted -->List books released in a time window.",
+		Attributes: map[string]schema.Attribute{
+			// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"start": schema.StringAttribute{
+				MarkdownDescription: "Window start in RFC3339 format. Defaults to today.",
+				Optional:            true,
+			},
+			"end": schema.StringAttribute{
+				MarkdownDescription: "Window end in RFC3339 format. Defaults to two days after today.",
+				Optional:            true,
+			},
+			"include_unmonitored": schema.BoolAttribute{
+				MarkdownDescription: "Include unmonitored books.",
+				Optional:            true,
+			},
+			"books": schema.SetNestedAttribute{
+				MarkdownDescription: "Book list.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							MarkdownDescription: "Book ID.",
+							Computed:            true,
+						},
+						"title": schema.StringAttribute{
+							MarkdownDescription: "Book title.",
+							Computed:            true,
+						},
+						"foreign_book_id": schema.StringAttribute{
+							MarkdownDescription: "Foreign book ID.",
+							Computed:            true,
+						},
+						"author_id": schema.Int64Attribute{
+							MarkdownDescription: "Author ID.",
+							Computed:            true,
+						},
+						"author_name": schema.StringAttribute{
+							MarkdownDescription: "Author name.",
+							Computed:            true,
+						},
+						"foreign_author_id": schema.StringAttribute{
+							MarkdownDescription: "Foreign author ID.",
+							Computed:            true,
+						},
+						"release_date": schema.StringAttribute{
+							MarkdownDescription: "Release date.",
+							Computed:            true,
+						},
+						"monitored": schema.BoolAttribute{
+							MarkdownDescription: "Monitored flag.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *CalendarDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if client := helpers.DataSourceConfigure(ctx, req, resp); client != nil {
+		d.client = client
+	}
+}
+
+func (d *CalendarDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *Calendar
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request := d.client.CalendarAPI.ListCalendar(ctx).IncludeAuthor(true).Unmonitored(data.IncludeUnmonitored.ValueBool())
+
+	if !data.Start.IsNull() {
+		start, err := time.Parse(time.RFC3339, data.Start.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(helpers.DataSourceError, "Invalid start: "+err.Error())
+
+			return
+		}
+
+		request = request.Start(start)
+	}
+
+	if !data.End.IsNull() {
+		end, err := time.Parse(time.RFC3339, data.End.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(helpers.DataSourceError, "Invalid end: "+err.Error())
+
+			return
+		}
+
+		request = request.End(end)
+	}
+
+	// Get calendar current value
+	response, _, err := request.Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.List, calendarDataSourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+calendarDataSourceName)
+
+	books := make([]WantedBook, len(response))
+	for i := range response {
+		books[i].write(&response[i])
+	}
+
+	bookList, diags := types.SetValueFrom(ctx, WantedBook{}.getType(), books)
+	resp.Diagnostics.Append(diags...)
+
+	data.Books = bookList
+	data.ID = types.StringValue(strconv.Itoa(len(response)))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
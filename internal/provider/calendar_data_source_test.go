@@ -0,0 +1,47 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccCalendarDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized
+			{
+				Config:      testAccCalendarDataSourceConfig("2023-01-01T00:00:00Z") + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Invalid date
+			{
+				Config:      testAccCalendarDataSourceConfig("2023-01-01"),
+				ExpectError: regexp.MustCompile("Invalid start"),
+			},
+			// Read testing
+			{
+				Config: testAccCalendarDataSourceConfig("2023-01-01T00:00:00Z"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.readarr_calendar.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCalendarDataSourceConfig(start string) string {
+	return fmt.Sprintf(`
+	data "readarr_calendar" "test" {
+		start = "%s"
+		end = "2023-12-31T00:00:00Z"
+		include_unmonitored = true
+	}
+	`, start)
+}
@@ -0,0 +1,297 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/devopsarr/readarr-go/readarr"
+	"github.com/devopsarr/terraform-provider-readarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	commandResourceName = "command"
+	// commandPollInterval is the delay between two command status checks.
+	commandPollInterval = 2 * time.Second
+	// defaultCommandTimeout is used when no timeout is configured.
+	defaultCommandTimeout = 600
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &CommandResource{}
+
+func NewCommandResource() resource.Resource {
+	return &CommandResource{}
+}
+
+// CommandResource defines the command implementation.
+type CommandResource struct {
+	client *readarr.APIClient
+}
+
+// Command describes the command data model.
+type Command struct {
+	Triggers types.Map    `tfsdk:"triggers"`
+	Name     types.String `tfsdk:"name"`
+	Body     types.String `tfsdk:"body"`
+	Status   types.String `tfsdk:"status"`
+	Message  types.String `tfsdk:"message"`
+	Started  types.String `tfsdk:"started"`
+	Ended    types.String `tfsdk:"ended"`
+	Duration types.String `tfsdk:"duration"`
+	ID       types.Int64  `tfsdk:"id"`
+	Timeout  types.Int64  `tfsdk:"timeout"`
+}
+
+func (r *CommandResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + commandResourceName
+}
+
+func (r *CommandResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "<!-- subcategory:System -->Command resource.\nIt runs a command on creation and waits for its completion. Any change to `name`, `body` or `triggers` runs it again. Destroying it has no effect on Readarr.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Command ID.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Command name, e.g. `RssSync`, `RefreshAuthor`, `RescanFolders`, `RenameFiles`, `ApplicationUpdateCheck`.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"body": schema.StringAttribute{
+				MarkdownDescription: "JSON encoded command parameters, e.g. `jsonencode({ authorId = 1 })`.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary map of values that, when changed, will run the command again.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"timeout": schema.Int64Attribute{
+				MarkdownDescription: "Seconds to wait for the command completion. Defaults to 600.",
+				Optional:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Command final status.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"message": schema.StringAttribute{
+				MarkdownDescription: "Command result message.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"started": schema.StringAttribute{
+				MarkdownDescription: "Command start time.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"ended": schema.StringAttribute{
+				MarkdownDescription: "Command end time.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"duration": schema.StringAttribute{
+				MarkdownDescription: "Command duration.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *CommandResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
+		r.client = client
+	}
+}
+
+func (r *CommandResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var command *Command
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &command)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Run command
+	request := command.read(&resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response := runCommand(ctx, r.client, command.Name.ValueString(), request, command.Timeout, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "created "+commandResourceName+": "+strconv.Itoa(int(response.GetId())))
+	// Generate resource state struct
+	command.write(response)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &command)...)
+}
+
+func (r *CommandResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Commands are purged by Readarr after a while, the state is kept as is.
+	var command *Command
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &command)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "read "+commandResourceName+": "+strconv.Itoa(int(command.ID.ValueInt64())))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &command)...)
+}
+
+func (r *CommandResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Only timeout can be updated in place, no need to run the command again.
+	var command *Command
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &command)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "updated "+commandResourceName+": "+strconv.Itoa(int(command.ID.ValueInt64())))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &command)...)
+}
+
+func (r *CommandResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Commands cannot be reverted
+	tflog.Trace(ctx, "deleted "+commandResourceName)
+	resp.State.RemoveResource(ctx)
+}
+
+// runCommand posts a command and waits for its completion.
+func runCommand(ctx context.Context, client *readarr.APIClient, name string, request map[string]interface{}, timeout types.Int64, diags *diag.Diagnostics) *readarr.CommandResource {
+	id := startCommand(ctx, client, name, request, diags)
+	if diags.HasError() {
+		return nil
+	}
+
+	return waitCommand(ctx, client, name, id, timeout, diags)
+}
+
+// startCommand posts a command and returns its ID without waiting for it.
+// Command parameters are not part of the SDK model, so the request is sent raw.
+func startCommand(ctx context.Context, client *readarr.APIClient, name string, request map[string]interface{}, diags *diag.Diagnostics) int32 {
+	var response readarr.CommandResource
+
+	if request == nil {
+		request = make(map[string]interface{})
+	}
+
+	request["name"] = name
+
+	if err := sendRawRequest(ctx, client, "CommandAPIService.CreateCommand", http.MethodPost, "/api/v1/command", request, &response); err != nil {
+		diags.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, commandResourceName, err))
+	}
+
+	return response.GetId()
+}
+
+// waitCommand polls a command until it reaches a final status, raising an error if it does not complete successfully.
+func waitCommand(ctx context.Context, client *readarr.APIClient, name string, id int32, timeout types.Int64, diags *diag.Diagnostics) *readarr.CommandResource {
+	seconds := int64(defaultCommandTimeout)
+	if !timeout.IsNull() {
+		seconds = timeout.ValueInt64()
+	}
+
+	deadline := time.Now().Add(time.Duration(seconds) * time.Second)
+
+	for {
+		command, _, err := client.CommandAPI.GetCommandById(ctx, id).Execute()
+		if err != nil {
+			diags.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, commandResourceName, err))
+
+			return nil
+		}
+
+		switch command.GetStatus() {
+		case readarr.COMMANDSTATUS_QUEUED, readarr.COMMANDSTATUS_STARTED:
+		case readarr.COMMANDSTATUS_COMPLETED:
+			return command
+		default:
+			diags.AddError(helpers.ResourceError, fmt.Sprintf("Command %s ended with status %s: %s %s", name, command.GetStatus(), command.GetMessage(), command.GetException()))
+
+			return nil
+		}
+
+		if time.Now().After(deadline) {
+			diags.AddError(helpers.ResourceError, fmt.Sprintf("Timeout waiting for command %s, last status: %s", name, command.GetStatus()))
+
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			diags.AddError(helpers.ResourceError, fmt.Sprintf("Interrupted waiting for command %s: %s", name, ctx.Err()))
+
+			return nil
+		case <-time.After(commandPollInterval):
+		}
+	}
+}
+
+func (c *Command) write(command *readarr.CommandResource) {
+	c.ID = types.Int64Value(int64(command.GetId()))
+	c.Status = types.StringValue(string(command.GetStatus()))
+	c.Message = types.StringValue(command.GetMessage())
+	c.Started = helpers.TimeValue(command.GetStarted())
+	c.Ended = helpers.TimeValue(command.GetEnded())
+	c.Duration = types.StringValue(command.GetDuration())
+}
+
+func (c *Command) read(diags *diag.Diagnostics) map[string]interface{} {
+	request := make(map[string]interface{})
+
+	if !c.Body.IsNull() {
+		if err := json.Unmarshal([]byte(c.Body.ValueString()), &request); err != nil {
+			diags.AddError(helpers.ResourceError, "Invalid body: "+err.Error())
+
+			return nil
+		}
+	}
+
+	return request
+}
@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccCommandResource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized Create
+			{
+				Config:      testAccCommandResourceConfig("CheckHealth", "first") + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Invalid body
+			{
+				Config:      `resource "readarr_command" "test" {` + "\n" + `name = "CheckHealth"` + "\n" + `body = "error"` + "\n}",
+				ExpectError: regexp.MustCompile("Invalid body"),
+			},
+			// Create and Read testing
+			{
+				Config: testAccCommandResourceConfig("CheckHealth", "first"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("readarr_command.test", "id"),
+					resource.TestCheckResourceAttrSet("readarr_command.test", "duration"),
+					resource.TestCheckResourceAttr("readarr_command.test", "status", "completed"),
+				),
+			},
+			// Trigger testing
+			{
+				Config: testAccCommandResourceConfig("CheckHealth", "second"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("readarr_command.test", "triggers.run", "second"),
+					resource.TestCheckResourceAttr("readarr_command.test", "status", "completed"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccCommandResourceConfig(name, trigger string) string {
+	return fmt.Sprintf(`
+		resource "readarr_command" "test" {
+			name = "%s"
+			body = jsonencode({})
+			timeout = 60
+			triggers = {
+				run = "%s"
+			}
+		}
+	`, name, trigger)
+}
@@ -0,0 +1,204 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/devopsarr/readarr-go/readarr"
+	"github.com/devopsarr/terraform-provider-readarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const developmentConfigResourceName = "development_config"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &DevelopmentConfigResource{}
+	_ resource.ResourceWithImportState = &DevelopmentConfigResource{}
+)
+
+func NewDevelopmentConfigResource() resource.Resource {
+	return &DevelopmentConfigResource{}
+}
+
+// DevelopmentConfigResource defines the development config implementation.
+type DevelopmentConfigResource struct {
+	client *readarr.APIClient
+}
+
+// DevelopmentConfig describes the development config data model.
+type DevelopmentConfig struct {
+	MetadataSource     types.String `tfsdk:"metadata_source"`
+	ConsoleLogLevel    types.String `tfsdk:"console_log_level"`
+	ID                 types.Int64  `tfsdk:"id"`
+	LogRotate          types.Int64  `tfsdk:"log_rotate"`
+	LogSQL             types.Bool   `tfsdk:"log_sql"`
+	FilterSentryEvents types.Bool   `tfsdk:"filter_sentry_events"`
+}
+
+func (r *DevelopmentConfigResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + developmentConfigResourceName
+}
+
+func (r *DevelopmentConfigResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "<!-- subcategory:System -->Development Config resource.\nIt manages the hidden development settings, reachable in the UI at `/settings/development`.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Development Config ID.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"metadata_source": schema.StringAttribute{
+				MarkdownDescription: "Metadata source URL. Empty to use the default one.",
+				Required:            true,
+			},
+			"console_log_level": schema.StringAttribute{
+				MarkdownDescription: "Console log level. Empty to use the log level.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("", "trace", "debug", "info", "warn", "error", "fatal"),
+				},
+			},
+			"log_sql": schema.BoolAttribute{
+				MarkdownDescription: "Log SQL statements.",
+				Required:            true,
+			},
+			"log_rotate": schema.Int64Attribute{
+				MarkdownDescription: "Number of log files to keep.",
+				Required:            true,
+			},
+			"filter_sentry_events": schema.BoolAttribute{
+				MarkdownDescription: "Filter analytics events.",
+				Required:            true,
+			},
+		},
+	}
+}
+
+func (r *DevelopmentConfigResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
+		r.client = client
+	}
+}
+
+func (r *DevelopmentConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var config *DevelopmentConfig
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Build Create resource
+	request := config.read()
+	request.SetId(1)
+
+	// Create new DevelopmentConfig
+	response, _, err := r.client.DevelopmentConfigAPI.UpdateDevelopmentConfig(ctx, strconv.Itoa(int(request.GetId()))).DevelopmentConfigResource(*request).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, developmentConfigResourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "created "+developmentConfigResourceName+": "+strconv.Itoa(int(response.GetId())))
+	// Generate resource state struct
+	config.write(response)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+func (r *DevelopmentConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var config *DevelopmentConfig
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get developmentConfig current value
+	response, _, err := r.client.DevelopmentConfigAPI.GetDevelopmentConfig(ctx).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, developmentConfigResourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+developmentConfigResourceName+": "+strconv.Itoa(int(response.GetId())))
+	// Map response body to resource schema attribute
+	config.write(response)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+func (r *DevelopmentConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Get plan values
+	var config *DevelopmentConfig
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Build Update resource
+	request := config.read()
+
+	// Update DevelopmentConfig
+	response, _, err := r.client.DevelopmentConfigAPI.UpdateDevelopmentConfig(ctx, strconv.Itoa(int(request.GetId()))).DevelopmentConfigResource(*request).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, developmentConfigResourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "updated "+developmentConfigResourceName+": "+strconv.Itoa(int(response.GetId())))
+	// Generate resource state struct
+	config.write(response)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+func (r *DevelopmentConfigResource) Delete(ctx context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// DevelopmentConfig cannot be really deleted just removing configuration
+	tflog.Trace(ctx, "decoupled "+developmentConfigResourceName+": 1")
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *DevelopmentConfigResource) ImportState(ctx context.Context, _ resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Trace(ctx, "imported "+developmentConfigResourceName+": 1")
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), 1)...)
+}
+
+func (c *DevelopmentConfig) write(developmentConfig *readarr.DevelopmentConfigResource) {
+	c.ID = types.Int64Value(int64(developmentConfig.GetId()))
+	c.MetadataSource = types.StringValue(developmentConfig.GetMetadataSource())
+	c.ConsoleLogLevel = types.StringValue(developmentConfig.GetConsoleLogLevel())
+	c.LogSQL = types.BoolValue(developmentConfig.GetLogSql())
+	c.LogRotate = types.Int64Value(int64(developmentConfig.GetLogRotate()))
+	c.FilterSentryEvents = types.BoolValue(developmentConfig.GetFilterSentryEvents())
+}
+
+func (c *DevelopmentConfig) read() *readarr.DevelopmentConfigResource {
+	config := readarr.NewDevelopmentConfigResource()
+	config.SetMetadataSource(c.MetadataSource.ValueString())
+	config.SetConsoleLogLevel(c.ConsoleLogLevel.ValueString())
+	config.SetLogSql(c.LogSQL.ValueBool())
+	config.SetLogRotate(int32(c.LogRotate.ValueInt64()))
+	config.SetFilterSentryEvents(c.FilterSentryEvents.ValueBool())
+	config.SetId(int32(c.ID.ValueInt64()))
+
+	return config
+}
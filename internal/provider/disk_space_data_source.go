@@ -0,0 +1,173 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/devopsarr/readarr-go/readarr"
+	"github.com/devopsarr/terraform-provider-readarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const diskSpaceDataSourceName = "disk_space"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &DiskSpaceDataSource{}
+
+func NewDiskSpaceDataSource() datasource.DataSource {
+	return &DiskSpaceDataSource{}
+}
+
+// DiskSpaceDataSource defines the disk space implementation.
+type DiskSpaceDataSource struct {
+	client *readarr.APIClient
+}
+
+// DiskSpaces describes the disk space data model.
+type DiskSpaces struct {
+	DiskSpaces     types.Set    `tfsdk:"disk_spaces"`
+	ID             types.String `tfsdk:"id"`
+	RootFolderPath types.String `tfsdk:"root_folder_path"`
+}
+
+// DiskSpace is part of DiskSpaces.
+type DiskSpace struct {
+	Path       types.String `tfsdk:"path"`
+	Label      types.String `tfsdk:"label"`
+	FreeSpace  types.Int64  `tfsdk:"free_space"`
+	TotalSpace types.Int64  `tfsdk:"total_space"`
+}
+
+func (d DiskSpace) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"path":        types.StringType,
+			"label":       types.StringType,
+			"free_space":  types.Int64Type,
+			"total_space": types.Int64Type,
+		})
+}
+
+func (d *DiskSpaceDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + diskSpaceDataSourceName
+}
+
+func (d *DiskSpaceDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "<!-- subcategory:System -->List the disk space of all mounts.\nIf `root_folder_path` is set, only the mount containing it is returned.",
+		Attributes: map[string]schema.Attribute{
+			// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"root_folder_path": schema.StringAttribute{
+				MarkdownDescription: "Root folder path to look up.",
+				Optional:            true,
+			},
+			"disk_spaces": schema.SetNestedAttribute{
+				MarkdownDescription: "Disk space list.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"path": schema.StringAttribute{
+							MarkdownDescription: "Mount path.",
+							Computed:            true,
+						},
+						"label": schema.StringAttribute{
+							MarkdownDescription: "Mount label.",
+							Computed:            true,
+						},
+						"free_space": schema.Int64Attribute{
+							MarkdownDescription: "Free space in bytes.",
+							Computed:            true,
+						},
+						"total_space": schema.Int64Attribute{
+							MarkdownDescription: "Total space in bytes.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *DiskSpaceDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if client := helpers.DataSourceConfigure(ctx, req, resp); client != nil {
+		d.client = client
+	}
+}
+
+func (d *DiskSpaceDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *DiskSpaces
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get disk space current value
+	response, _, err := d.client.DiskSpaceAPI.ListDiskSpace(ctx).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.List, diskSpaceDataSourceName, err))
+
+		return
+	}
+
+	if !data.RootFolderPath.IsNull() {
+		mount := diskSpaceMount(data.RootFolderPath.ValueString(), response)
+		if mount == nil {
+			resp.Diagnostics.AddError(helpers.DataSourceError, helpers.ParseNotFoundError(diskSpaceDataSourceName, "root folder path", data.RootFolderPath.ValueString()))
+
+			return
+		}
+
+		response = []readarr.DiskSpaceResource{*mount}
+	}
+
+	tflog.Trace(ctx, "read "+diskSpaceDataSourceName)
+
+	disks := make([]DiskSpace, len(response))
+	for i := range response {
+		disks[i].write(&response[i])
+	}
+
+	diskList, diags := types.SetValueFrom(ctx, DiskSpace{}.getType(), disks)
+	resp.Diagnostics.Append(diags...)
+
+	data.DiskSpaces = diskList
+	data.ID = types.StringValue(strconv.Itoa(len(response)))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// diskSpaceMount returns the mount containing the given path, choosing the most specific one.
+func diskSpaceMount(path string, disks []readarr.DiskSpaceResource) *readarr.DiskSpaceResource {
+	var mount *readarr.DiskSpaceResource
+
+	for i := range disks {
+		mountPath := strings.TrimRight(disks[i].GetPath(), `/\`)
+		if path != mountPath && !strings.HasPrefix(path, mountPath+"/") && !strings.HasPrefix(path, mountPath+`\`) {
+			continue
+		}
+
+		if mount == nil || len(disks[i].GetPath()) > len(mount.GetPath()) {
+			mount = &disks[i]
+		}
+	}
+
+	return mount
+}
+
+func (d *DiskSpace) write(disk *readarr.DiskSpaceResource) {
+	d.Path = types.StringValue(disk.GetPath())
+	d.Label = types.StringValue(disk.GetLabel())
+	d.FreeSpace = types.Int64Value(disk.GetFreeSpace())
+	d.TotalSpace = types.Int64Value(disk.GetTotalSpace())
+}
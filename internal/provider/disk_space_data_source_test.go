@@ -0,0 +1,101 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/devopsarr/readarr-go/readarr"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccDiskSpaceDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized
+			{
+				Config:      testAccDiskSpaceDataSourceConfig + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Read testing
+			{
+				Config: testAccDiskSpaceDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.readarr_disk_space.test", "id"),
+				),
+			},
+			// Lookup testing
+			{
+				PreConfig: rootFolderDSInit,
+				Config:    testAccDiskSpaceDataSourceLookupConfig("/config"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.readarr_disk_space.test", "id", "1"),
+					resource.TestCheckResourceAttr("data.readarr_disk_space.test", "disk_spaces.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDiskSpaceDataSourceConfig = `
+data "readarr_disk_space" "test" {
+}
+`
+
+func testAccDiskSpaceDataSourceLookupConfig(path string) string {
+	return fmt.Sprintf(`
+	data "readarr_disk_space" "test" {
+		root_folder_path = "%s"
+	}
+	`, path)
+}
+
+func TestDiskSpaceMount(t *testing.T) {
+	t.Parallel()
+
+	disks := []readarr.DiskSpaceResource{
+		{Path: *readarr.NewNullableString(readarr.PtrString("/"))},
+		{Path: *readarr.NewNullableString(readarr.PtrString("/config"))},
+		{Path: *readarr.NewNullableString(readarr.PtrString("/books"))},
+		{Path: *readarr.NewNullableString(readarr.PtrString(`D:\`))},
+	}
+
+	tests := map[string]struct {
+		path     string
+		expected string
+	}{
+		"exact": {
+			path:     "/books",
+			expected: "/books",
+		},
+		"nested": {
+			path:     "/books/fantasy",
+			expected: "/books",
+		},
+		"root": {
+			path:     "/booksextra",
+			expected: "/",
+		},
+		"windows": {
+			path:     `D:\books`,
+			expected: `D:\`,
+		},
+		"missing": {
+			path:     `E:\books`,
+			expected: "",
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, test.expected, diskSpaceMount(test.path, disks).GetPath())
+		})
+	}
+}
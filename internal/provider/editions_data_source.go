@@ -0,0 +1,199 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/devopsarr/readarr-go/readarr"
+	"github.com/devopsarr/terraform-provider-readarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const editionsDataSourceName = "editions"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &EditionsDataSource{}
+
+func NewEditionsDataSource() datasource.DataSource {
+	return &EditionsDataSource{}
+}
+
+// EditionsDataSource defines the editions implementation.
+type EditionsDataSource struct {
+	client *readarr.APIClient
+}
+
+// Editions describes the editions data model.
+type Editions struct {
+	Editions types.Set    `tfsdk:"editions"`
+	ID       types.String `tfsdk:"id"`
+	BookID   types.Int64  `tfsdk:"book_id"`
+}
+
+// Edition is part of Editions.
+type Edition struct {
+	ForeignEditionID types.String `tfsdk:"foreign_edition_id"`
+	Title            types.String `tfsdk:"title"`
+	Format           types.String `tfsdk:"format"`
+	Isbn13           types.String `tfsdk:"isbn13"`
+	Asin             types.String `tfsdk:"asin"`
+	Publisher        types.String `tfsdk:"publisher"`
+	Language         types.String `tfsdk:"language"`
+	ReleaseDate      types.String `tfsdk:"release_date"`
+	ID               types.Int64  `tfsdk:"id"`
+	PageCount        types.Int64  `tfsdk:"page_count"`
+	IsEbook          types.Bool   `tfsdk:"is_ebook"`
+	Monitored        types.Bool   `tfsdk:"monitored"`
+}
+
+func (e Edition) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"foreign_edition_id": types.StringType,
+			"title":              types.StringType,
+			"format":             types.StringType,
+			"isbn13":             types.StringType,
+			"asin":               types.StringType,
+			"publisher":          types.StringType,
+			"language":           types.StringType,
+			"release_date":       types.StringType,
+			"id":                 types.Int64Type,
+			"page_count":         types.Int64Type,
+			"is_ebook":           types.BoolType,
+			"monitored":          types.BoolType,
+		})
+}
+
+func (d *EditionsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + editionsDataSourceName
+}
+
+func (d *EditionsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "<!-- subcategory:Books -->List all editions of a [Book](../resources/book).",
+		Attributes: map[string]schema.Attribute{
+			// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"book_id": schema.Int64Attribute{
+				MarkdownDescription: "Book ID.",
+				Required:            true,
+			},
+			"editions": schema.SetNestedAttribute{
+				MarkdownDescription: "Edition list.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							MarkdownDescription: "Edition ID.",
+							Computed:            true,
+						},
+						"foreign_edition_id": schema.StringAttribute{
+							MarkdownDescription: "Foreign edition ID.",
+							Computed:            true,
+						},
+						"title": schema.StringAttribute{
+							MarkdownDescription: "Edition title.",
+							Computed:            true,
+						},
+						"format": schema.StringAttribute{
+							MarkdownDescription: "Format.",
+							Computed:            true,
+						},
+						"isbn13": schema.StringAttribute{
+							MarkdownDescription: "ISBN13.",
+							Computed:            true,
+						},
+						"asin": schema.StringAttribute{
+							MarkdownDescription: "ASIN.",
+							Computed:            true,
+						},
+						"publisher": schema.StringAttribute{
+							MarkdownDescription: "Publisher.",
+							Computed:            true,
+						},
+						"language": schema.StringAttribute{
+							MarkdownDescription: "Language.",
+							Computed:            true,
+						},
+						"release_date": schema.StringAttribute{
+							MarkdownDescription: "Release date.",
+							Computed:            true,
+						},
+						"page_count": schema.Int64Attribute{
+							MarkdownDescription: "Page count.",
+							Computed:            true,
+						},
+						"is_ebook": schema.BoolAttribute{
+							MarkdownDescription: "Ebook flag.",
+							Computed:            true,
+						},
+						"monitored": schema.BoolAttribute{
+							MarkdownDescription: "Monitored flag.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *EditionsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if client := helpers.DataSourceConfigure(ctx, req, resp); client != nil {
+		d.client = client
+	}
+}
+
+func (d *EditionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *Editions
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get editions current value
+	response, _, err := d.client.EditionAPI.ListEdition(ctx).BookId([]int32{int32(data.BookID.ValueInt64())}).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.List, editionsDataSourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+editionsDataSourceName)
+
+	editions := make([]Edition, len(response))
+	for i := range response {
+		editions[i].write(&response[i])
+	}
+
+	editionList, diags := types.SetValueFrom(ctx, Edition{}.getType(), editions)
+	resp.Diagnostics.Append(diags...)
+
+	data.Editions = editionList
+	data.ID = types.StringValue(strconv.Itoa(len(response)))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (e *Edition) write(edition *readarr.EditionResource) {
+	e.ID = types.Int64Value(int64(edition.GetId()))
+	e.ForeignEditionID = types.StringValue(edition.GetForeignEditionId())
+	e.Title = types.StringValue(edition.GetTitle())
+	e.Format = types.StringValue(edition.GetFormat())
+	e.Isbn13 = types.StringValue(edition.GetIsbn13())
+	e.Asin = types.StringValue(edition.GetAsin())
+	e.Publisher = types.StringValue(edition.GetPublisher())
+	e.Language = types.StringValue(edition.GetLanguage())
+	e.ReleaseDate = helpers.TimeValue(edition.GetReleaseDate())
+	e.PageCount = types.Int64Value(int64(edition.GetPageCount()))
+	e.IsEbook = types.BoolValue(edition.GetIsEbook())
+	e.Monitored = types.BoolValue(edition.GetMonitored())
+}
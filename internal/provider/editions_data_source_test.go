@@ -0,0 +1,41 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccEditionsDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized
+			{
+				Config:      testAccEditionsDataSourceConfig("1") + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Read testing
+			{
+				PreConfig: rootFolderDSInit,
+				Config:    testAccBookResourceConfig("2657", "true") + testAccEditionsDataSourceConfig("readarr_book.test.id"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("data.readarr_editions.test", "editions.*", map[string]string{"monitored": "true"}),
+				),
+			},
+		},
+	})
+}
+
+func testAccEditionsDataSourceConfig(bookID string) string {
+	return fmt.Sprintf(`
+	data "readarr_editions" "test" {
+		book_id = %s
+	}
+	`, bookID)
+}
@@ -0,0 +1,172 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/devopsarr/readarr-go/readarr"
+	"github.com/devopsarr/terraform-provider-readarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const healthDataSourceName = "health"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &HealthDataSource{}
+
+func NewHealthDataSource() datasource.DataSource {
+	return &HealthDataSource{}
+}
+
+// HealthDataSource defines the health implementation.
+type HealthDataSource struct {
+	client *readarr.APIClient
+}
+
+// Health describes the health data model.
+type Health struct {
+	Checks types.Set    `tfsdk:"checks"`
+	ID     types.String `tfsdk:"id"`
+	FailOn types.String `tfsdk:"fail_on"`
+}
+
+// HealthCheck is part of Health.
+type HealthCheck struct {
+	Source  types.String `tfsdk:"source"`
+	Type    types.String `tfsdk:"type"`
+	Message types.String `tfsdk:"message"`
+	WikiURL types.String `tfsdk:"wiki_url"`
+}
+
+func (h HealthCheck) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"source":   types.StringType,
+			"type":     types.StringType,
+			"message":  types.StringType,
+			"wiki_url": types.StringType,
+		})
+}
+
+func (d *HealthDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + healthDataSourceName
+}
+
+func (d *HealthDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "<!-- subcategory:System -->List all health checks.\nIf `fail_on` is set, an error is raised for each check at or above the given level.",
+		Attributes: map[string]schema.Attribute{
+			// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"fail_on": schema.StringAttribute{
+				MarkdownDescription: "Raise an error on checks at or above this level. `error` or `warning`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(string(readarr.HEALTHCHECKRESULT_ERROR), string(readarr.HEALTHCHECKRESULT_WARNING)),
+				},
+			},
+			"checks": schema.SetNestedAttribute{
+				MarkdownDescription: "Health check list.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"source": schema.StringAttribute{
+							MarkdownDescription: "Check source.",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "Check result. `ok`, `notice`, `warning` or `error`.",
+							Computed:            true,
+						},
+						"message": schema.StringAttribute{
+							MarkdownDescription: "Check message.",
+							Computed:            true,
+						},
+						"wiki_url": schema.StringAttribute{
+							MarkdownDescription: "Wiki URL.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *HealthDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if client := helpers.DataSourceConfigure(ctx, req, resp); client != nil {
+		d.client = client
+	}
+}
+
+func (d *HealthDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *Health
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get health current value
+	response, _, err := d.client.HealthAPI.ListHealth(ctx).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.List, healthDataSourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+healthDataSourceName)
+	data.write(ctx, response, &resp.Diagnostics)
+	data.check(response, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (h *Health) write(ctx context.Context, checks []readarr.HealthResource, diags *diag.Diagnostics) {
+	healthChecks := make([]HealthCheck, len(checks))
+	for i := range checks {
+		healthChecks[i].write(&checks[i])
+	}
+
+	checkList, tempDiag := types.SetValueFrom(ctx, HealthCheck{}.getType(), healthChecks)
+	diags.Append(tempDiag...)
+
+	h.Checks = checkList
+	h.ID = types.StringValue(strconv.Itoa(len(checks)))
+}
+
+// check raises an error for each health check at or above the fail_on level.
+func (h *Health) check(checks []readarr.HealthResource, diags *diag.Diagnostics) {
+	if h.FailOn.IsNull() {
+		return
+	}
+
+	failing := map[readarr.HealthCheckResult]bool{readarr.HEALTHCHECKRESULT_ERROR: true}
+	if h.FailOn.ValueString() == string(readarr.HEALTHCHECKRESULT_WARNING) {
+		failing[readarr.HEALTHCHECKRESULT_WARNING] = true
+	}
+
+	for i := range checks {
+		if failing[checks[i].GetType()] {
+			diags.AddError("Health Check Failed", fmt.Sprintf("%s %s: %s", checks[i].GetSource(), checks[i].GetType(), checks[i].GetMessage()))
+		}
+	}
+}
+
+func (c *HealthCheck) write(check *readarr.HealthResource) {
+	c.Source = types.StringValue(check.GetSource())
+	c.Type = types.StringValue(string(check.GetType()))
+	c.Message = types.StringValue(check.GetMessage())
+	c.WikiURL = types.StringValue(check.GetWikiUrl())
+}
@@ -0,0 +1,90 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/devopsarr/readarr-go/readarr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccHealthDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized
+			{
+				Config:      testAccHealthDataSourceConfig("error") + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Invalid level
+			{
+				Config:      testAccHealthDataSourceConfig("notice"),
+				ExpectError: regexp.MustCompile("Invalid Attribute Value Match"),
+			},
+			// Read testing
+			{
+				Config: testAccHealthDataSourceConfig("error"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.readarr_health.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccHealthDataSourceConfig(failOn string) string {
+	return fmt.Sprintf(`
+	data "readarr_health" "test" {
+		fail_on = "%s"
+	}
+	`, failOn)
+}
+
+func TestHealthCheck(t *testing.T) {
+	t.Parallel()
+
+	checks := []readarr.HealthResource{
+		{Source: *readarr.NewNullableString(readarr.PtrString("IndexerCheck")), Type: readarr.HEALTHCHECKRESULT_WARNING.Ptr()},
+		{Source: *readarr.NewNullableString(readarr.PtrString("UpdateCheck")), Type: readarr.HEALTHCHECKRESULT_NOTICE.Ptr()},
+		{Source: *readarr.NewNullableString(readarr.PtrString("RootFolderCheck")), Type: readarr.HEALTHCHECKRESULT_ERROR.Ptr()},
+	}
+
+	tests := map[string]struct {
+		failOn   types.String
+		expected int
+	}{
+		"unset": {
+			failOn:   types.StringNull(),
+			expected: 0,
+		},
+		"error": {
+			failOn:   types.StringValue("error"),
+			expected: 1,
+		},
+		"warning": {
+			failOn:   types.StringValue("warning"),
+			expected: 2,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var diags diag.Diagnostics
+
+			health := Health{FailOn: test.failOn}
+			health.check(checks, &diags)
+			assert.Equal(t, test.expected, diags.ErrorsCount())
+		})
+	}
+}
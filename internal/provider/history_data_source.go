@@ -0,0 +1,303 @@
+package provider
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/devopsarr/readarr-go/readarr"
+	"github.com/devopsarr/terraform-provider-readarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const historyDataSourceName = "history"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &HistoryDataSource{}
+
+func NewHistoryDataSource() datasource.DataSource {
+	return &HistoryDataSource{}
+}
+
+// HistoryDataSource defines the history implementation.
+type HistoryDataSource struct {
+	client *readarr.APIClient
+}
+
+// History describes the history data model.
+type History struct {
+	History   types.Set    `tfsdk:"history"`
+	ID        types.String `tfsdk:"id"`
+	EventType types.String `tfsdk:"event_type"`
+	Start     types.String `tfsdk:"start"`
+	End       types.String `tfsdk:"end"`
+	AuthorID  types.Int64  `tfsdk:"author_id"`
+	BookID    types.Int64  `tfsdk:"book_id"`
+}
+
+// HistoryRecord is part of History.
+type HistoryRecord struct {
+	SourceTitle types.String `tfsdk:"source_title"`
+	EventType   types.String `tfsdk:"event_type"`
+	QualityName types.String `tfsdk:"quality_name"`
+	DownloadID  types.String `tfsdk:"download_id"`
+	Indexer     types.String `tfsdk:"indexer"`
+	Date        types.String `tfsdk:"date"`
+	ID          types.Int64  `tfsdk:"id"`
+	AuthorID    types.Int64  `tfsdk:"author_id"`
+	BookID      types.Int64  `tfsdk:"book_id"`
+}
+
+func (h HistoryRecord) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"source_title": types.StringType,
+			"event_type":   types.StringType,
+			"quality_name": types.StringType,
+			"download_id":  types.StringType,
+			"indexer":      types.StringType,
+			"date":         types.StringType,
+			"id":           types.Int64Type,
+			"author_id":    types.Int64Type,
+			"book_id":      types.Int64Type,
+		})
+}
+
+func (d *HistoryDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + historyDataSourceName
+}
+
+func (d *HistoryDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "<!-- subcategory:Activity -->List history records, optionally filtered.",
+		Attributes: map[string]schema.Attribute{
+			// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"event_type": schema.StringAttribute{
+				MarkdownDescription: "Filter by event type.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(readarr.ENTITYHISTORYEVENTTYPE_UNKNOWN),
+						string(readarr.ENTITYHISTORYEVENTTYPE_GRABBED),
+						string(readarr.ENTITYHISTORYEVENTTYPE_BOOK_FILE_IMPORTED),
+						string(readarr.ENTITYHISTORYEVENTTYPE_DOWNLOAD_FAILED),
+						string(readarr.ENTITYHISTORYEVENTTYPE_BOOK_FILE_DELETED),
+						string(readarr.ENTITYHISTORYEVENTTYPE_BOOK_FILE_RENAMED),
+						string(readarr.ENTITYHISTORYEVENTTYPE_BOOK_IMPORT_INCOMPLETE),
+						string(readarr.ENTITYHISTORYEVENTTYPE_DOWNLOAD_IMPORTED),
+						string(readarr.ENTITYHISTORYEVENTTYPE_BOOK_FILE_RETAGGED),
+						string(readarr.ENTITYHISTORYEVENTTYPE_DOWNLOAD_IGNORED),
+					),
+				},
+			},
+			"author_id": schema.Int64Attribute{
+				MarkdownDescription: "Filter by author ID.",
+				Optional:            true,
+			},
+			"book_id": schema.Int64Attribute{
+				MarkdownDescription: "Filter by book ID.",
+				Optional:            true,
+			},
+			"start": schema.StringAttribute{
+				MarkdownDescription: "Only records after this RFC3339 date.",
+				Optional:            true,
+			},
+			"end": schema.StringAttribute{
+				MarkdownDescription: "Only records before this RFC3339 date.",
+				Optional:            true,
+			},
+			"history": schema.SetNestedAttribute{
+				MarkdownDescription: "History record list.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							MarkdownDescription: "History record ID.",
+							Computed:            true,
+						},
+						"author_id": schema.Int64Attribute{
+							MarkdownDescription: "Author ID.",
+							Computed:            true,
+						},
+						"book_id": schema.Int64Attribute{
+							MarkdownDescription: "Book ID.",
+							Computed:            true,
+						},
+						"event_type": schema.StringAttribute{
+							MarkdownDescription: "Event type.",
+							Computed:            true,
+						},
+						"source_title": schema.StringAttribute{
+							MarkdownDescription: "Source title.",
+							Computed:            true,
+						},
+						"quality_name": schema.StringAttribute{
+							MarkdownDescription: "Quality name.",
+							Computed:            true,
+						},
+						"download_id": schema.StringAttribute{
+							MarkdownDescription: "Download client ID.",
+							Computed:            true,
+						},
+						"indexer": schema.StringAttribute{
+							MarkdownDescription: "Indexer name.",
+							Computed:            true,
+						},
+						"date": schema.StringAttribute{
+							MarkdownDescription: "Event date.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *HistoryDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if client := helpers.DataSourceConfigure(ctx, req, resp); client != nil {
+		d.client = client
+	}
+}
+
+func (d *HistoryDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var (
+		data     *History
+		response []readarr.HistoryResource
+		err      error
+	)
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter := data.filter(&resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get history current value, using the narrowest endpoint available
+	switch {
+	case !data.Start.IsNull():
+		request := d.client.HistoryAPI.ListHistorySince(ctx).Date(filter.start)
+		if !data.EventType.IsNull() {
+			request = request.EventType(readarr.EntityHistoryEventType(data.EventType.ValueString()))
+		}
+
+		response, _, err = request.Execute()
+	case !data.AuthorID.IsNull():
+		request := d.client.HistoryAPI.ListHistoryAuthor(ctx).AuthorId(int32(data.AuthorID.ValueInt64()))
+		if !data.EventType.IsNull() {
+			request = request.EventType(readarr.EntityHistoryEventType(data.EventType.ValueString()))
+		}
+
+		response, _, err = request.Execute()
+	default:
+		response, err = listPaged[readarr.HistoryResource](ctx, d.client, "HistoryAPIService.GetHistory", "/api/v1/history", url.Values{})
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.List, historyDataSourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+historyDataSourceName)
+	data.write(ctx, response, filter, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// historyFilter holds the parsed history filters.
+type historyFilter struct {
+	start     time.Time
+	end       time.Time
+	eventType string
+	authorID  int64
+	bookID    int64
+}
+
+func (h *History) filter(diags *diag.Diagnostics) historyFilter {
+	var err error
+
+	filter := historyFilter{
+		eventType: h.EventType.ValueString(),
+		authorID:  h.AuthorID.ValueInt64(),
+		bookID:    h.BookID.ValueInt64(),
+	}
+
+	if !h.Start.IsNull() {
+		if filter.start, err = time.Parse(time.RFC3339, h.Start.ValueString()); err != nil {
+			diags.AddError(helpers.DataSourceError, "Invalid start: "+err.Error())
+		}
+	}
+
+	if !h.End.IsNull() {
+		if filter.end, err = time.Parse(time.RFC3339, h.End.ValueString()); err != nil {
+			diags.AddError(helpers.DataSourceError, "Invalid end: "+err.Error())
+		}
+	}
+
+	return filter
+}
+
+// match applies every filter client side, since each endpoint supports only part of them.
+func (f historyFilter) match(record *readarr.HistoryResource) bool {
+	switch {
+	case f.eventType != "" && string(record.GetEventType()) != f.eventType,
+		f.authorID != 0 && int64(record.GetAuthorId()) != f.authorID,
+		f.bookID != 0 && int64(record.GetBookId()) != f.bookID,
+		!f.start.IsZero() && record.GetDate().Before(f.start),
+		!f.end.IsZero() && record.GetDate().After(f.end):
+		return false
+	}
+
+	return true
+}
+
+func (h *History) write(ctx context.Context, records []readarr.HistoryResource, filter historyFilter, diags *diag.Diagnostics) {
+	history := make([]HistoryRecord, 0, len(records))
+
+	for i := range records {
+		if !filter.match(&records[i]) {
+			continue
+		}
+
+		record := HistoryRecord{}
+		record.write(&records[i])
+		history = append(history, record)
+	}
+
+	historyList, tempDiag := types.SetValueFrom(ctx, HistoryRecord{}.getType(), history)
+	diags.Append(tempDiag...)
+
+	h.History = historyList
+	h.ID = types.StringValue(strconv.Itoa(len(history)))
+}
+
+func (r *HistoryRecord) write(record *readarr.HistoryResource) {
+	quality := record.Quality.GetQuality()
+
+	r.ID = types.Int64Value(int64(record.GetId()))
+	r.AuthorID = types.Int64Value(int64(record.GetAuthorId()))
+	r.BookID = types.Int64Value(int64(record.GetBookId()))
+	r.EventType = types.StringValue(string(record.GetEventType()))
+	r.SourceTitle = types.StringValue(record.GetSourceTitle())
+	r.QualityName = types.StringValue(quality.GetName())
+	r.DownloadID = types.StringValue(record.GetDownloadId())
+	r.Indexer = types.StringValue(record.GetData()["indexer"])
+	r.Date = helpers.TimeValue(record.GetDate())
+}
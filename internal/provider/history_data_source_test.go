@@ -0,0 +1,109 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/devopsarr/readarr-go/readarr"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccHistoryDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized
+			{
+				Config:      testAccHistoryDataSourceConfig("event_type = \"grabbed\"") + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Invalid date
+			{
+				Config:      testAccHistoryDataSourceConfig("start = \"2023-01-01\""),
+				ExpectError: regexp.MustCompile("Invalid start"),
+			},
+			// Read testing
+			{
+				Config: testAccHistoryDataSourceConfig("event_type = \"grabbed\""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.readarr_history.test", "id"),
+				),
+			},
+			// Read since testing
+			{
+				Config: testAccHistoryDataSourceConfig("start = \"2023-01-01T00:00:00Z\"\nend = \"2023-02-01T00:00:00Z\""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.readarr_history.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccHistoryDataSourceConfig(filter string) string {
+	return fmt.Sprintf(`
+	data "readarr_history" "test" {
+		%s
+	}
+	`, filter)
+}
+
+func TestHistoryFilterMatch(t *testing.T) {
+	t.Parallel()
+
+	date := time.Date(2023, 1, 15, 0, 0, 0, 0, time.UTC)
+	record := readarr.HistoryResource{
+		AuthorId:  readarr.PtrInt32(1),
+		BookId:    readarr.PtrInt32(2),
+		EventType: readarr.ENTITYHISTORYEVENTTYPE_GRABBED.Ptr(),
+		Date:      &date,
+	}
+
+	tests := map[string]struct {
+		filter   historyFilter
+		expected bool
+	}{
+		"empty": {
+			filter:   historyFilter{},
+			expected: true,
+		},
+		"matching": {
+			filter:   historyFilter{eventType: "grabbed", authorID: 1, bookID: 2, start: date.AddDate(0, 0, -1), end: date.AddDate(0, 0, 1)},
+			expected: true,
+		},
+		"event type": {
+			filter:   historyFilter{eventType: "downloadFailed"},
+			expected: false,
+		},
+		"author": {
+			filter:   historyFilter{authorID: 3},
+			expected: false,
+		},
+		"book": {
+			filter:   historyFilter{bookID: 3},
+			expected: false,
+		},
+		"before start": {
+			filter:   historyFilter{start: date.AddDate(0, 0, 1)},
+			expected: false,
+		},
+		"after end": {
+			filter:   historyFilter{end: date.AddDate(0, 0, -1)},
+			expected: false,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, test.expected, test.filter.match(&record))
+		})
+	}
+}
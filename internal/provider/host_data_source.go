@@ -64,6 +64,11 @@ func (d *HostDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, r
 				MarkdownDescription: "Instance name.",
 				Computed:            true,
 			},
+			"api_key": schema.StringAttribute{
+				MarkdownDescription: "API key.",
+				Computed:            true,
+				Sensitive:           true,
+			},
 			"update": schema.SingleNestedAttribute{
 				MarkdownDescription: "Update configuration.",
 				Computed:            true,
@@ -23,7 +23,8 @@ func TestAccHostDataSource(t *testing.T) {
 			{
 				Config: testAccHostDataSourceConfig,
 				Check: resource.ComposeAggregateTestCheckFunc(
-					resource.TestCheckResourceAttrSet("data.readarr_host.test", "id")),
+					resource.TestCheckResourceAttrSet("data.readarr_host.test", "id"),
+					resource.TestCheckResourceAttrSet("data.readarr_host.test", "api_key")),
 			},
 		},
 	})
@@ -52,6 +52,7 @@ type Host struct {
 	ApplicationURL types.String `tfsdk:"application_url"`
 	BindAddress    types.String `tfsdk:"bind_address"`
 	URLBase        types.String `tfsdk:"url_base"`
+	APIKey         types.String `tfsdk:"api_key"`
 	ID             types.Int64  `tfsdk:"id"`
 	Port           types.Int64  `tfsdk:"port"`
 	LaunchBrowser  types.Bool   `tfsdk:"launch_browser"`
@@ -214,6 +215,11 @@ func (r *HostResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				MarkdownDescription: "Instance name.",
 				Required:            true,
 			},
+			"api_key": schema.StringAttribute{
+				MarkdownDescription: "API key.",
+				Computed:            true,
+				Sensitive:           true,
+			},
 			"update": schema.SingleNestedAttribute{
 				MarkdownDescription: "Update configuration.",
 				Required:            true,
@@ -499,6 +505,7 @@ func (h *Host) write(ctx context.Context, host *readarr.HostConfigResource, diag
 	h.ApplicationURL = types.StringValue(host.GetApplicationUrl())
 	h.BindAddress = types.StringValue(host.GetBindAddress())
 	h.URLBase = types.StringValue(host.GetUrlBase())
+	h.APIKey = types.StringValue(host.GetApiKey())
 	h.ID = types.Int64Value(int64(host.GetId()))
 	h.Port = types.Int64Value(int64(host.GetPort()))
 	h.LaunchBrowser = types.BoolValue(host.GetLaunchBrowser())
@@ -0,0 +1,24 @@
+package provider
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// joinIDs builds a stable ID out of a list of IDs, sorting them and joining them with commas.
+func joinIDs(ids []int32) string {
+	sorted := make([]int, len(ids))
+	for i, id := range ids {
+		sorted[i] = int(id)
+	}
+
+	sort.Ints(sorted)
+
+	parts := make([]string, len(sorted))
+	for i, id := range sorted {
+		parts[i] = strconv.Itoa(id)
+	}
+
+	return strings.Join(parts, ",")
+}
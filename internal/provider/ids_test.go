@@ -0,0 +1,37 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJoinIDs(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		ids      []int32
+		expected string
+	}{
+		"empty": {
+			ids:      []int32{},
+			expected: "",
+		},
+		"single": {
+			ids:      []int32{3},
+			expected: "3",
+		},
+		"unsorted": {
+			ids:      []int32{10, 2, 7},
+			expected: "2,7,10",
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, test.expected, joinIDs(test.ids))
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/devopsarr/readarr-go/readarr"
+)
+
+// pageSize is the number of records requested for each page.
+const pageSize = 250
+
+// pagingResource is the generic envelope returned by paged endpoints.
+type pagingResource[T any] struct {
+	Records      []T   `json:"records"`
+	Page         int32 `json:"page"`
+	PageSize     int32 `json:"pageSize"`
+	TotalRecords int32 `json:"totalRecords"`
+}
+
+// listPaged retrieves every record of a paged endpoint, since the SDK does not expose the paging parameters.
+func listPaged[T any](ctx context.Context, client *readarr.APIClient, operation, path string, query url.Values) ([]T, error) {
+	if query == nil {
+		query = url.Values{}
+	}
+
+	query.Set("pageSize", strconv.Itoa(pageSize))
+
+	var records []T
+
+	for page := 1; ; page++ {
+		query.Set("page", strconv.Itoa(page))
+
+		var response pagingResource[T]
+		if err := sendRawRequest(ctx, client, operation, http.MethodGet, path+"?"+query.Encode(), nil, &response); err != nil {
+			return nil, err
+		}
+
+		records = append(records, response.Records...)
+
+		if len(response.Records) == 0 || len(records) >= int(response.TotalRecords) {
+			return records, nil
+		}
+	}
+}
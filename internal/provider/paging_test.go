@@ -0,0 +1,82 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/devopsarr/readarr-go/readarr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListPaged(t *testing.T) {
+	t.Parallel()
+
+	records := make([]int, pageSize+10)
+	for i := range records {
+		records[i] = i
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-API-Key") != "key" {
+			w.WriteHeader(http.StatusUnauthorized)
+
+			return
+		}
+
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		start := (page - 1) * pageSize
+		end := start + pageSize
+
+		if end > len(records) {
+			end = len(records)
+		}
+
+		_ = json.NewEncoder(w).Encode(pagingResource[int]{
+			Records:      records[start:end],
+			Page:         int32(page),
+			PageSize:     pageSize,
+			TotalRecords: int32(len(records)),
+		})
+	}))
+	t.Cleanup(server.Close)
+
+	tests := map[string]struct {
+		key      string
+		expected []int
+		err      bool
+	}{
+		"all_pages": {
+			key:      "key",
+			expected: records,
+		},
+		"unauthorized": {
+			key: "wrong",
+			err: true,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			config := readarr.NewConfiguration()
+			config.AddDefaultHeader("X-API-Key", test.key)
+			config.Servers[0].URL = server.URL
+
+			result, err := listPaged[int](context.Background(), readarr.NewAPIClient(config), "MissingAPIService.GetWantedMissing", "/api/v1/wanted/missing", nil)
+			if test.err {
+				assert.Error(t, err)
+
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, test.expected, result)
+		})
+	}
+}
@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"net/http"
 	"os"
 
 	"github.com/devopsarr/readarr-go/readarr"
@@ -119,9 +120,9 @@ func (p *ReadarrProvider) Configure(ctx context.Context, req provider.ConfigureR
 		return
 	}
 
-	// Configuring client. API Key management could be changed once new options avail in sdk.
+	// Configuring client. The API key is set by the transport, so that it can be rotated during the run.
 	config := readarr.NewConfiguration()
-	config.AddDefaultHeader("X-API-Key", key)
+	config.HTTPClient = &http.Client{Transport: newAPIKeyTransport(key)}
 	config.Servers[0].URL = url
 	client := readarr.NewAPIClient(config)
 
@@ -131,8 +132,17 @@ func (p *ReadarrProvider) Configure(ctx context.Context, req provider.ConfigureR
 
 func (p *ReadarrProvider) Resources(_ context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
+		// Activity
+		NewBlocklistRemovalResource,
+		NewQueueRemovalResource,
+
 		// Author
 		NewAuthorResource,
+		NewAuthorEditorResource,
+
+		// Books
+		NewBookResource,
+		NewSeriesMonitorResource,
 
 		// Download Clients
 		NewDownloadClientConfigResource,
@@ -220,18 +230,39 @@ func (p *ReadarrProvider) Resources(_ context.Context) []func() resource.Resourc
 		NewCustomFormatResource,
 
 		// System
+		NewAPIKeyRotationResource,
+		NewBackupResource,
+		NewBackupRestoreResource,
+		NewCommandResource,
+		NewDevelopmentConfigResource,
 		NewHostResource,
 
 		// Tags
 		NewTagResource,
+
+		// UI
+		NewUIConfigResource,
 	}
 }
 
 func (p *ReadarrProvider) DataSources(_ context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
+		// Activity
+		NewBlocklistDataSource,
+		NewHistoryDataSource,
+		NewQueueDataSource,
+
 		// Author
 		NewAuthorDataSource,
 		NewAuthorsDataSource,
+		NewAuthorLookupDataSource,
+
+		// Books
+		NewBookFilesDataSource,
+		NewBookLookupDataSource,
+		NewBooksDataSource,
+		NewEditionsDataSource,
+		NewSeriesDataSource,
 
 		// Download Clients
 		NewDownloadClientConfigDataSource,
@@ -284,12 +315,26 @@ func (p *ReadarrProvider) DataSources(_ context.Context) []func() datasource.Dat
 		NewCustomFormatConditionSizeDataSource,
 
 		// System
+		NewBackupsDataSource,
+		NewDevelopmentConfigDataSource,
+		NewDiskSpaceDataSource,
+		NewHealthDataSource,
 		NewHostDataSource,
 		NewSystemStatusDataSource,
+		NewSystemTasksDataSource,
+		NewUpdatesDataSource,
 
 		// Tags
 		NewTagDataSource,
 		NewTagsDataSource,
+
+		// UI
+		NewUIConfigDataSource,
+
+		// Wanted
+		NewCalendarDataSource,
+		NewWantedCutoffDataSource,
+		NewWantedMissingDataSource,
 	}
 }
 
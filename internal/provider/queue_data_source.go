@@ -0,0 +1,284 @@
+package provider
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+
+	"github.com/devopsarr/readarr-go/readarr"
+	"github.com/devopsarr/terraform-provider-readarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const queueDataSourceName = "queue"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &QueueDataSource{}
+
+func NewQueueDataSource() datasource.DataSource {
+	return &QueueDataSource{}
+}
+
+// QueueDataSource defines the queue implementation.
+type QueueDataSource struct {
+	client *readarr.APIClient
+}
+
+// Queue describes the queue data model.
+type Queue struct {
+	Items                     types.Set    `tfsdk:"items"`
+	ID                        types.String `tfsdk:"id"`
+	IncludeUnknownAuthorItems types.Bool   `tfsdk:"include_unknown_author_items"`
+}
+
+// QueueItem is part of Queue.
+type QueueItem struct {
+	StatusMessages          types.List    `tfsdk:"status_messages"`
+	Title                   types.String  `tfsdk:"title"`
+	Status                  types.String  `tfsdk:"status"`
+	TrackedDownloadStatus   types.String  `tfsdk:"tracked_download_status"`
+	TrackedDownloadState    types.String  `tfsdk:"tracked_download_state"`
+	ErrorMessage            types.String  `tfsdk:"error_message"`
+	Protocol                types.String  `tfsdk:"protocol"`
+	DownloadClient          types.String  `tfsdk:"download_client"`
+	DownloadID              types.String  `tfsdk:"download_id"`
+	Indexer                 types.String  `tfsdk:"indexer"`
+	OutputPath              types.String  `tfsdk:"output_path"`
+	QualityName             types.String  `tfsdk:"quality_name"`
+	AuthorName              types.String  `tfsdk:"author_name"`
+	BookTitle               types.String  `tfsdk:"book_title"`
+	Timeleft                types.String  `tfsdk:"timeleft"`
+	EstimatedCompletionTime types.String  `tfsdk:"estimated_completion_time"`
+	Size                    types.Float64 `tfsdk:"size"`
+	Sizeleft                types.Float64 `tfsdk:"sizeleft"`
+	ID                      types.Int64   `tfsdk:"id"`
+	AuthorID                types.Int64   `tfsdk:"author_id"`
+	BookID                  types.Int64   `tfsdk:"book_id"`
+}
+
+func (q QueueItem) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"status_messages":           types.ListType{}.WithElementType(types.StringType),
+			"title":                     types.StringType,
+			"status":                    types.StringType,
+			"tracked_download_status":   types.StringType,
+			"tracked_download_state":    types.StringType,
+			"error_message":             types.StringType,
+			"protocol":                  types.StringType,
+			"download_client":           types.StringType,
+			"download_id":               types.StringType,
+			"indexer":                   types.StringType,
+			"output_path":               types.StringType,
+			"quality_name":              types.StringType,
+			"author_name":               types.StringType,
+			"book_title":                types.StringType,
+			"timeleft":                  types.StringType,
+			"estimated_completion_time": types.StringType,
+			"size":                      types.Float64Type,
+			"sizeleft":                  types.Float64Type,
+			"id":                        types.Int64Type,
+			"author_id":                 types.Int64Type,
+			"book_id":                   types.Int64Type,
+		})
+}
+
+func (d *QueueDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + queueDataSourceName
+}
+
+func (d *QueueDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "<!-- subcategory:Activity -->List all items in the download queue.",
+		Attributes: map[string]schema.Attribute{
+			// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"include_unknown_author_items": schema.BoolAttribute{
+				MarkdownDescription: "Include items not linked to an author.",
+				Optional:            true,
+			},
+			"items": schema.SetNestedAttribute{
+				MarkdownDescription: "Queue item list.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							MarkdownDescription: "Queue item ID.",
+							Computed:            true,
+						},
+						"title": schema.StringAttribute{
+							MarkdownDescription: "Release title.",
+							Computed:            true,
+						},
+						"author_id": schema.Int64Attribute{
+							MarkdownDescription: "Author ID.",
+							Computed:            true,
+						},
+						"author_name": schema.StringAttribute{
+							MarkdownDescription: "Author name.",
+							Computed:            true,
+						},
+						"book_id": schema.Int64Attribute{
+							MarkdownDescription: "Book ID.",
+							Computed:            true,
+						},
+						"book_title": schema.StringAttribute{
+							MarkdownDescription: "Book title.",
+							Computed:            true,
+						},
+						"quality_name": schema.StringAttribute{
+							MarkdownDescription: "Quality name.",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "Download client status.",
+							Computed:            true,
+						},
+						"tracked_download_status": schema.StringAttribute{
+							MarkdownDescription: "Tracked download status. `ok`, `warning` or `error`.",
+							Computed:            true,
+						},
+						"tracked_download_state": schema.StringAttribute{
+							MarkdownDescription: "Tracked download state.",
+							Computed:            true,
+						},
+						"status_messages": schema.ListAttribute{
+							MarkdownDescription: "Status messages.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"error_message": schema.StringAttribute{
+							MarkdownDescription: "Error message.",
+							Computed:            true,
+						},
+						"protocol": schema.StringAttribute{
+							MarkdownDescription: "Download protocol.",
+							Computed:            true,
+						},
+						"download_client": schema.StringAttribute{
+							MarkdownDescription: "Download client name.",
+							Computed:            true,
+						},
+						"download_id": schema.StringAttribute{
+							MarkdownDescription: "Download client ID.",
+							Computed:            true,
+						},
+						"indexer": schema.StringAttribute{
+							MarkdownDescription: "Indexer name.",
+							Computed:            true,
+						},
+						"output_path": schema.StringAttribute{
+							MarkdownDescription: "Output path.",
+							Computed:            true,
+						},
+						"size": schema.Float64Attribute{
+							MarkdownDescription: "Size in bytes.",
+							Computed:            true,
+						},
+						"sizeleft": schema.Float64Attribute{
+							MarkdownDescription: "Size left in bytes.",
+							Computed:            true,
+						},
+						"timeleft": schema.StringAttribute{
+							MarkdownDescription: "Time left.",
+							Computed:            true,
+						},
+						"estimated_completion_time": schema.StringAttribute{
+							MarkdownDescription: "Estimated completion time.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *QueueDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if client := helpers.DataSourceConfigure(ctx, req, resp); client != nil {
+		d.client = client
+	}
+}
+
+func (d *QueueDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *Queue
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get queue current value
+	query := url.Values{}
+	query.Set("includeAuthor", "true")
+	query.Set("includeBook", "true")
+	query.Set("includeUnknownAuthorItems", strconv.FormatBool(data.IncludeUnknownAuthorItems.ValueBool()))
+
+	response, err := listPaged[readarr.QueueResource](ctx, d.client, "QueueAPIService.GetQueue", "/api/v1/queue", query)
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.List, queueDataSourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+queueDataSourceName)
+
+	items := make([]QueueItem, len(response))
+	for i := range response {
+		items[i].write(ctx, &response[i], &resp.Diagnostics)
+	}
+
+	itemList, diags := types.SetValueFrom(ctx, QueueItem{}.getType(), items)
+	resp.Diagnostics.Append(diags...)
+
+	data.Items = itemList
+	data.ID = types.StringValue(strconv.Itoa(len(response)))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (q *QueueItem) write(ctx context.Context, item *readarr.QueueResource, diags *diag.Diagnostics) {
+	var tempDiag diag.Diagnostics
+
+	author := item.GetAuthor()
+	book := item.GetBook()
+	quality := item.Quality.GetQuality()
+	messages := make([]string, 0, len(item.StatusMessages))
+
+	for _, status := range item.StatusMessages {
+		for _, message := range status.Messages {
+			messages = append(messages, status.GetTitle()+": "+message)
+		}
+	}
+
+	q.ID = types.Int64Value(int64(item.GetId()))
+	q.Title = types.StringValue(item.GetTitle())
+	q.AuthorID = types.Int64Value(int64(item.GetAuthorId()))
+	q.AuthorName = types.StringValue(author.GetAuthorName())
+	q.BookID = types.Int64Value(int64(item.GetBookId()))
+	q.BookTitle = types.StringValue(book.GetTitle())
+	q.QualityName = types.StringValue(quality.GetName())
+	q.Status = types.StringValue(item.GetStatus())
+	q.TrackedDownloadStatus = types.StringValue(string(item.GetTrackedDownloadStatus()))
+	q.TrackedDownloadState = types.StringValue(string(item.GetTrackedDownloadState()))
+	q.ErrorMessage = types.StringValue(item.GetErrorMessage())
+	q.Protocol = types.StringValue(string(item.GetProtocol()))
+	q.DownloadClient = types.StringValue(item.GetDownloadClient())
+	q.DownloadID = types.StringValue(item.GetDownloadId())
+	q.Indexer = types.StringValue(item.GetIndexer())
+	q.OutputPath = types.StringValue(item.GetOutputPath())
+	q.Size = types.Float64Value(item.GetSize())
+	q.Sizeleft = types.Float64Value(item.GetSizeleft())
+	q.Timeleft = types.StringValue(item.GetTimeleft())
+	q.EstimatedCompletionTime = helpers.TimeValue(item.GetEstimatedCompletionTime())
+	q.StatusMessages, tempDiag = types.ListValueFrom(ctx, types.StringType, messages)
+	diags.Append(tempDiag...)
+}
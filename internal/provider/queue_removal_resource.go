@@ -0,0 +1,163 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/devopsarr/readarr-go/readarr"
+	"github.com/devopsarr/terraform-provider-readarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const queueRemovalResourceName = "queue_removal"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &QueueRemovalResource{}
+
+func NewQueueRemovalResource() resource.Resource {
+	return &QueueRemovalResource{}
+}
+
+// QueueRemovalResource defines the queue removal implementation.
+type QueueRemovalResource struct {
+	client *readarr.APIClient
+}
+
+// QueueRemoval describes the queue removal data model.
+type QueueRemoval struct {
+	QueueIDs         types.Set    `tfsdk:"queue_ids"`
+	ID               types.String `tfsdk:"id"`
+	RemoveFromClient types.Bool   `tfsdk:"remove_from_client"`
+	Blocklist        types.Bool   `tfsdk:"blocklist"`
+	SkipRedownload   types.Bool   `tfsdk:"skip_redownload"`
+}
+
+func (r *QueueRemovalResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + queueRemovalResourceName
+}
+
+func (r *QueueRemovalResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "<!-- subcategory:Activity -->Queue removal resource.\nIt removes the given items from the [queue](../data-sources/queue) on creation. Any change runs it again. Destroying it has no effect on Readarr.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Queue removal ID. Sorted list of the queue item IDs.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"queue_ids": schema.SetAttribute{
+				MarkdownDescription: "Queue item IDs.",
+				Required:            true,
+				ElementType:         types.Int64Type,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+			"remove_from_client": schema.BoolAttribute{
+				MarkdownDescription: "Remove the download from the download client.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"blocklist": schema.BoolAttribute{
+				MarkdownDescription: "Add the release to the blocklist.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"skip_redownload": schema.BoolAttribute{
+				MarkdownDescription: "Skip searching a replacement release when blocklisting.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *QueueRemovalResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
+		r.client = client
+	}
+}
+
+func (r *QueueRemovalResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var removal *QueueRemoval
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &removal)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Remove queue items
+	request := readarr.NewQueueBulkResource()
+	resp.Diagnostics.Append(removal.QueueIDs.ElementsAs(ctx, &request.Ids, true)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.QueueAPI.DeleteQueueBulk(ctx).
+		RemoveFromClient(removal.RemoveFromClient.ValueBool()).
+		Blocklist(removal.Blocklist.ValueBool()).
+		SkipReDownload(removal.SkipRedownload.ValueBool()).
+		QueueBulkResource(*request).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, queueRemovalResourceName, err))
+
+		return
+	}
+
+	removal.ID = types.StringValue(joinIDs(request.GetIds()))
+
+	tflog.Trace(ctx, "created "+queueRemovalResourceName+": "+removal.ID.ValueString())
+	// Generate resource state struct
+	resp.Diagnostics.Append(resp.State.Set(ctx, &removal)...)
+}
+
+func (r *QueueRemovalResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Removed items are gone from the queue, the state is kept as is.
+	var removal *QueueRemoval
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &removal)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "read "+queueRemovalResourceName+": "+removal.ID.ValueString())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &removal)...)
+}
+
+func (r *QueueRemovalResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Every attribute requires replace, nothing to update in place.
+	var removal *QueueRemoval
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &removal)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "updated "+queueRemovalResourceName+": "+removal.ID.ValueString())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &removal)...)
+}
+
+func (r *QueueRemovalResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Removed items cannot be restored
+	tflog.Trace(ctx, "deleted "+queueRemovalResourceName)
+	resp.State.RemoveResource(ctx)
+}
@@ -0,0 +1,33 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccQueueRemovalResource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized Create
+			{
+				Config:      testAccQueueRemovalResourceConfig + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// The test instance has no download client, so the queue is always empty
+		},
+	})
+}
+
+const testAccQueueRemovalResourceConfig = `
+resource "readarr_queue_removal" "test" {
+	queue_ids = [1]
+	remove_from_client = true
+	blocklist = true
+}
+`
@@ -0,0 +1,110 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+
+	"github.com/devopsarr/readarr-go/readarr"
+)
+
+// sendRawRequest calls an endpoint with parameters or payloads not supported by the SDK.
+// The request is built on top of the client configuration, the body is sent as JSON and the response decoded into out, if not nil.
+// If out is an io.Writer, the response is copied as is.
+func sendRawRequest(ctx context.Context, client *readarr.APIClient, operation, method, path string, body, out interface{}) error {
+	var (
+		payload     io.Reader
+		contentType string
+	)
+
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+
+		payload = bytes.NewReader(data)
+		contentType = "application/json"
+	}
+
+	return doRawRequest(ctx, client, operation, method, path, contentType, payload, out)
+}
+
+// sendRawUpload posts a file as multipart form, since the SDK does not expose file parameters.
+func sendRawUpload(ctx context.Context, client *readarr.APIClient, operation, path, fileName string, content io.Reader, out interface{}) error {
+	var payload bytes.Buffer
+
+	writer := multipart.NewWriter(&payload)
+
+	part, err := writer.CreateFormFile("file", fileName)
+	if err != nil {
+		return err
+	}
+
+	if _, err = io.Copy(part, content); err != nil {
+		return err
+	}
+
+	if err = writer.Close(); err != nil {
+		return err
+	}
+
+	return doRawRequest(ctx, client, operation, http.MethodPost, path, writer.FormDataContentType(), &payload, out)
+}
+
+func doRawRequest(ctx context.Context, client *readarr.APIClient, operation, method, path, contentType string, payload io.Reader, out interface{}) error {
+	config := client.GetConfig()
+
+	basePath, err := config.ServerURLWithContext(ctx, operation)
+	if err != nil {
+		return err
+	}
+
+	request, err := http.NewRequestWithContext(ctx, method, basePath+path, payload)
+	if err != nil {
+		return err
+	}
+
+	request.Header.Set("Accept", "application/json")
+
+	if contentType != "" {
+		request.Header.Set("Content-Type", contentType)
+	}
+
+	for k, v := range config.DefaultHeader {
+		request.Header.Set(k, v)
+	}
+
+	httpClient := config.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	httpResp, err := httpClient.Do(request)
+	if err != nil {
+		return err
+	}
+
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode >= http.StatusMultipleChoices {
+		details, _ := io.ReadAll(httpResp.Body)
+
+		return fmt.Errorf("%s\nDetails:\n%s", httpResp.Status, details)
+	}
+
+	switch o := out.(type) {
+	case nil:
+		return nil
+	case io.Writer:
+		_, err = io.Copy(o, httpResp.Body)
+
+		return err
+	default:
+		return json.NewDecoder(httpResp.Body).Decode(out)
+	}
+}
@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/devopsarr/readarr-go/readarr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSendRawUpload(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		file, header, err := r.FormFile("file")
+		if err != nil || r.Header.Get("X-API-Key") != "key" {
+			w.WriteHeader(http.StatusBadRequest)
+
+			return
+		}
+
+		content, _ := io.ReadAll(file)
+		_, _ = w.Write([]byte(header.Filename + ":" + string(content)))
+	}))
+	t.Cleanup(server.Close)
+
+	tests := map[string]struct {
+		key      string
+		expected string
+		err      bool
+	}{
+		"success": {
+			key:      "key",
+			expected: "backup.zip:content",
+		},
+		"error": {
+			key: "wrong",
+			err: true,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			config := readarr.NewConfiguration()
+			config.AddDefaultHeader("X-API-Key", test.key)
+			config.Servers[0].URL = server.URL
+
+			var out bytes.Buffer
+
+			err := sendRawUpload(context.Background(), readarr.NewAPIClient(config), "BackupAPIService.CreateSystemBackupRestoreUpload", "/upload", "backup.zip", strings.NewReader("content"), &out)
+			assert.Equal(t, test.err, err != nil)
+			assert.Equal(t, test.expected, out.String())
+		})
+	}
+}
@@ -0,0 +1,194 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/devopsarr/readarr-go/readarr"
+	"github.com/devopsarr/terraform-provider-readarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const seriesDataSourceName = "series"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &SeriesDataSource{}
+
+func NewSeriesDataSource() datasource.DataSource {
+	return &SeriesDataSource{}
+}
+
+// SeriesDataSource defines the series implementation.
+type SeriesDataSource struct {
+	client *readarr.APIClient
+}
+
+// SeriesList describes the series data model.
+type SeriesList struct {
+	Series   types.Set    `tfsdk:"series"`
+	Title    types.String `tfsdk:"title"`
+	ID       types.String `tfsdk:"id"`
+	AuthorID types.Int64  `tfsdk:"author_id"`
+}
+
+// Series is part of SeriesList.
+type Series struct {
+	BookIDs     types.Set    `tfsdk:"book_ids"`
+	Title       types.String `tfsdk:"title"`
+	Description types.String `tfsdk:"description"`
+	ID          types.Int64  `tfsdk:"id"`
+}
+
+func (s Series) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"book_ids":    types.SetType{}.WithElementType(types.Int64Type),
+			"title":       types.StringType,
+			"description": types.StringType,
+			"id":          types.Int64Type,
+		})
+}
+
+func (d *SeriesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + seriesDataSourceName
+}
+
+func (d *SeriesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "<!-- subcategory:Books -->List book series, filtered by author and/or title.",
+		Attributes: map[string]schema.Attribute{
+			// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"author_id": schema.Int64Attribute{
+				MarkdownDescription: "Author ID. If not set, series of every author are searched.",
+				Optional:            true,
+			},
+			"title": schema.StringAttribute{
+				MarkdownDescription: "Series title.",
+				Optional:            true,
+			},
+			"series": schema.SetNestedAttribute{
+				MarkdownDescription: "Series list.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							MarkdownDescription: "Series ID.",
+							Computed:            true,
+						},
+						"title": schema.StringAttribute{
+							MarkdownDescription: "Series title.",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "Series description.",
+							Computed:            true,
+						},
+						"book_ids": schema.SetAttribute{
+							MarkdownDescription: "IDs of the books in the series.",
+							Computed:            true,
+							ElementType:         types.Int64Type,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *SeriesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if client := helpers.DataSourceConfigure(ctx, req, resp); client != nil {
+		d.client = client
+	}
+}
+
+func (d *SeriesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *SeriesList
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Series can only be retrieved per author
+	var authorIDs []int32
+
+	if data.AuthorID.IsNull() {
+		authors, _, err := d.client.AuthorAPI.ListAuthor(ctx).Execute()
+		if err != nil {
+			resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.List, seriesDataSourceName, err))
+
+			return
+		}
+
+		for _, a := range authors {
+			authorIDs = append(authorIDs, a.GetId())
+		}
+	} else {
+		authorIDs = []int32{int32(data.AuthorID.ValueInt64())}
+	}
+
+	// Get series current value, removing duplicates shared among authors
+	seen := make(map[int32]bool)
+	series := []Series{}
+
+	for _, authorID := range authorIDs {
+		response, _, err := d.client.SeriesAPI.ListSeries(ctx).AuthorId(authorID).Execute()
+		if err != nil {
+			resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.List, seriesDataSourceName, err))
+
+			return
+		}
+
+		for i := range response {
+			if seen[response[i].GetId()] || (!data.Title.IsNull() && response[i].GetTitle() != data.Title.ValueString()) {
+				continue
+			}
+
+			seen[response[i].GetId()] = true
+			s := Series{}
+			s.write(ctx, &response[i], &resp.Diagnostics)
+			series = append(series, s)
+		}
+	}
+
+	tflog.Trace(ctx, "read "+seriesDataSourceName)
+
+	seriesList, diags := types.SetValueFrom(ctx, Series{}.getType(), series)
+	resp.Diagnostics.Append(diags...)
+
+	data.Series = seriesList
+	data.ID = types.StringValue(strconv.Itoa(len(series)))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (s *Series) write(ctx context.Context, series *readarr.SeriesResource, diags *diag.Diagnostics) {
+	var tempDiag diag.Diagnostics
+
+	s.ID = types.Int64Value(int64(series.GetId()))
+	s.Title = types.StringValue(series.GetTitle())
+	s.Description = types.StringValue(series.GetDescription())
+	s.BookIDs, tempDiag = types.SetValueFrom(ctx, types.Int64Type, seriesBookIDs(series))
+	diags.Append(tempDiag...)
+}
+
+// seriesBookIDs extracts the book IDs from the series links.
+func seriesBookIDs(series *readarr.SeriesResource) []int32 {
+	links := series.GetLinks()
+	ids := make([]int32, len(links))
+
+	for i, l := range links {
+		ids[i] = l.GetBookId()
+	}
+
+	return ids
+}
@@ -0,0 +1,43 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSeriesDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized
+			{
+				Config:      testAccSeriesDataSourceConfig("1", "Dune") + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Read testing
+			{
+				PreConfig: rootFolderDSInit,
+				Config:    testAccBookResourceConfig("234225", "true") + testAccSeriesDataSourceConfig("readarr_book.test.author_id", "Dune"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.readarr_series.test", "series.0.id"),
+					resource.TestCheckResourceAttr("data.readarr_series.test", "series.0.title", "Dune"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSeriesDataSourceConfig(authorID, title string) string {
+	return fmt.Sprintf(`
+	data "readarr_series" "test" {
+		author_id = %s
+		title = "%s"
+	}
+	`, authorID, title)
+}
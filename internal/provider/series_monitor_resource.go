@@ -0,0 +1,259 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/devopsarr/readarr-go/readarr"
+	"github.com/devopsarr/terraform-provider-readarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const seriesMonitorResourceName = "series_monitor"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &SeriesMonitorResource{}
+
+func NewSeriesMonitorResource() resource.Resource {
+	return &SeriesMonitorResource{}
+}
+
+// SeriesMonitorResource defines the series monitor implementation.
+type SeriesMonitorResource struct {
+	client *readarr.APIClient
+}
+
+// SeriesMonitor describes the series monitor data model.
+type SeriesMonitor struct {
+	BookIDs           types.Set    `tfsdk:"book_ids"`
+	Title             types.String `tfsdk:"title"`
+	ID                types.Int64  `tfsdk:"id"`
+	AuthorID          types.Int64  `tfsdk:"author_id"`
+	SeriesID          types.Int64  `tfsdk:"series_id"`
+	Monitored         types.Bool   `tfsdk:"monitored"`
+	UnmonitorOnDelete types.Bool   `tfsdk:"unmonitor_on_delete"`
+}
+
+func (r *SeriesMonitorResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + seriesMonitorResourceName
+}
+
+func (r *SeriesMonitorResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "<!-- subcategory:Books -->Series monitor resource.\nIt keeps the monitored flag of every book of a series aligned, including the ones added to the series after a refresh.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Series monitor ID. Same as the series ID.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"author_id": schema.Int64Attribute{
+				MarkdownDescription: "Author ID the series is retrieved from.",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"series_id": schema.Int64Attribute{
+				MarkdownDescription: "Series ID.",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"monitored": schema.BoolAttribute{
+				MarkdownDescription: "Monitored flag to be applied to every book of the series.",
+				Required:            true,
+			},
+			"unmonitor_on_delete": schema.BoolAttribute{
+				MarkdownDescription: "Unmonitor the series books on destroy. By default they are left untouched.",
+				Optional:            true,
+			},
+			"title": schema.StringAttribute{
+				MarkdownDescription: "Series title.",
+				Computed:            true,
+			},
+			"book_ids": schema.SetAttribute{
+				MarkdownDescription: "IDs of the books in the series.",
+				Computed:            true,
+				ElementType:         types.Int64Type,
+			},
+		},
+	}
+}
+
+func (r *SeriesMonitorResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
+		r.client = client
+	}
+}
+
+func (r *SeriesMonitorResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var monitor *SeriesMonitor
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &monitor)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Monitor series books
+	series := r.apply(ctx, monitor, monitor.Monitored.ValueBool(), helpers.Create, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "created "+seriesMonitorResourceName+": "+strconv.Itoa(int(series.GetId())))
+	// Generate resource state struct
+	monitor.write(ctx, series, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &monitor)...)
+}
+
+func (r *SeriesMonitorResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var monitor *SeriesMonitor
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &monitor)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get series current value
+	series := r.find(ctx, monitor, helpers.Read, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ids := seriesBookIDs(series)
+	if len(ids) > 0 {
+		books, _, err := r.client.BookAPI.ListBook(ctx).BookIds(ids).Execute()
+		if err != nil {
+			resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, seriesMonitorResourceName, err))
+
+			return
+		}
+
+		// Any book out of sync, as the new ones added on refresh, flips the flag to trigger an update
+		for _, b := range books {
+			if b.GetMonitored() != monitor.Monitored.ValueBool() {
+				monitor.Monitored = types.BoolValue(!monitor.Monitored.ValueBool())
+
+				break
+			}
+		}
+	}
+
+	tflog.Trace(ctx, "read "+seriesMonitorResourceName+": "+strconv.Itoa(int(series.GetId())))
+	// Map response body to resource schema attribute
+	monitor.write(ctx, series, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &monitor)...)
+}
+
+func (r *SeriesMonitorResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Get plan values
+	var monitor *SeriesMonitor
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &monitor)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Monitor series books
+	series := r.apply(ctx, monitor, monitor.Monitored.ValueBool(), helpers.Update, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "updated "+seriesMonitorResourceName+": "+strconv.Itoa(int(series.GetId())))
+	// Generate resource state struct
+	monitor.write(ctx, series, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &monitor)...)
+}
+
+func (r *SeriesMonitorResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var monitor *SeriesMonitor
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &monitor)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Unmonitor series books only if requested
+	if monitor.UnmonitorOnDelete.ValueBool() {
+		r.apply(ctx, monitor, false, helpers.Delete, &resp.Diagnostics)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	tflog.Trace(ctx, "deleted "+seriesMonitorResourceName+": "+strconv.Itoa(int(monitor.SeriesID.ValueInt64())))
+	resp.State.RemoveResource(ctx)
+}
+
+// find retrieves the series from the author series list.
+func (r *SeriesMonitorResource) find(ctx context.Context, monitor *SeriesMonitor, action string, diags *diag.Diagnostics) *readarr.SeriesResource {
+	response, _, err := r.client.SeriesAPI.ListSeries(ctx).AuthorId(int32(monitor.AuthorID.ValueInt64())).Execute()
+	if err != nil {
+		diags.AddError(helpers.ClientError, helpers.ParseClientError(action, seriesMonitorResourceName, err))
+
+		return nil
+	}
+
+	for i := range response {
+		if int64(response[i].GetId()) == monitor.SeriesID.ValueInt64() {
+			return &response[i]
+		}
+	}
+
+	diags.AddError(helpers.ResourceError, helpers.ParseNotFoundError(seriesMonitorResourceName, "series ID", strconv.Itoa(int(monitor.SeriesID.ValueInt64()))))
+
+	return nil
+}
+
+// apply sets the monitored flag on every book of the series.
+func (r *SeriesMonitorResource) apply(ctx context.Context, monitor *SeriesMonitor, monitored bool, action string, diags *diag.Diagnostics) *readarr.SeriesResource {
+	series := r.find(ctx, monitor, action, diags)
+	if diags.HasError() {
+		return nil
+	}
+
+	ids := seriesBookIDs(series)
+	if len(ids) == 0 {
+		return series
+	}
+
+	request := readarr.NewBooksMonitoredResource()
+	request.SetBookIds(ids)
+	request.SetMonitored(monitored)
+
+	if _, err := r.client.BookAPI.PutBookMonitor(ctx).BooksMonitoredResource(*request).Execute(); err != nil {
+		diags.AddError(helpers.ClientError, helpers.ParseClientError(action, seriesMonitorResourceName, err))
+
+		return nil
+	}
+
+	return series
+}
+
+func (s *SeriesMonitor) write(ctx context.Context, series *readarr.SeriesResource, diags *diag.Diagnostics) {
+	var tempDiag diag.Diagnostics
+
+	s.ID = types.Int64Value(int64(series.GetId()))
+	s.SeriesID = types.Int64Value(int64(series.GetId()))
+	s.Title = types.StringValue(series.GetTitle())
+	s.BookIDs, tempDiag = types.SetValueFrom(ctx, types.Int64Type, seriesBookIDs(series))
+	diags.Append(tempDiag...)
+}
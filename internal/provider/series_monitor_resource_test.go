@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSeriesMonitorResource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized Create
+			{
+				Config:      testAccSeriesMonitorResourceConfig("1", "1", "false") + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Create and Read testing
+			// The series monitor applies its flag to every book of the series, so the book must match it
+			{
+				PreConfig: rootFolderDSInit,
+				Config:    testAccBookResourceConfig("2767052", "true") + testAccSeriesDataSourceConfig("readarr_book.test.author_id", "The Hunger Games") + testAccSeriesMonitorResourceConfig("readarr_book.test.author_id", "tolist(data.readarr_series.test.series)[0].id", "true"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("readarr_series_monitor.test", "id"),
+					resource.TestCheckResourceAttr("readarr_series_monitor.test", "title", "The Hunger Games"),
+					resource.TestCheckResourceAttr("readarr_series_monitor.test", "monitored", "true"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccBookResourceConfig("2767052", "false") + testAccSeriesDataSourceConfig("readarr_book.test.author_id", "The Hunger Games") + testAccSeriesMonitorResourceConfig("readarr_book.test.author_id", "tolist(data.readarr_series.test.series)[0].id", "false"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("readarr_series_monitor.test", "monitored", "false"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccSeriesMonitorResourceConfig(authorID, seriesID, monitored string) string {
+	return fmt.Sprintf(`
+		resource "readarr_series_monitor" "test" {
+			author_id = %s
+			series_id = %s
+			monitored = %s
+		}
+	`, authorID, seriesID, monitored)
+}
@@ -0,0 +1,155 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/devopsarr/readarr-go/readarr"
+	"github.com/devopsarr/terraform-provider-readarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const systemTasksDataSourceName = "system_tasks"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &SystemTasksDataSource{}
+
+func NewSystemTasksDataSource() datasource.DataSource {
+	return &SystemTasksDataSource{}
+}
+
+// SystemTasksDataSource defines the system tasks implementation.
+type SystemTasksDataSource struct {
+	client *readarr.APIClient
+}
+
+// SystemTasks describes the system tasks data model.
+type SystemTasks struct {
+	SystemTasks types.Set    `tfsdk:"system_tasks"`
+	ID          types.String `tfsdk:"id"`
+}
+
+// SystemTask is part of SystemTasks.
+type SystemTask struct {
+	Name          types.String `tfsdk:"name"`
+	TaskName      types.String `tfsdk:"task_name"`
+	LastExecution types.String `tfsdk:"last_execution"`
+	LastStartTime types.String `tfsdk:"last_start_time"`
+	LastDuration  types.String `tfsdk:"last_duration"`
+	NextExecution types.String `tfsdk:"next_execution"`
+	ID            types.Int64  `tfsdk:"id"`
+	Interval      types.Int64  `tfsdk:"interval"`
+}
+
+func (t SystemTask) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"name":            types.StringType,
+			"task_name":       types.StringType,
+			"last_execution":  types.StringType,
+			"last_start_time": types.StringType,
+			"last_duration":   types.StringType,
+			"next_execution":  types.StringType,
+			"id":              types.Int64Type,
+			"interval":        types.Int64Type,
+		})
+}
+
+func (d *SystemTasksDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + systemTasksDataSourceName
+}
+
+func (d *SystemTasksDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "<!-- subcategory:System -->List all scheduled system tasks.",
+		Attributes: map[string]schema.Attribute{
+			// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"system_tasks": schema.SetNestedAttribute{
+				MarkdownDescription: "System task list.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							MarkdownDescription: "System task ID.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name.",
+							Computed:            true,
+						},
+						"task_name": schema.StringAttribute{
+							MarkdownDescription: "Task name, usable as command name.",
+							Computed:            true,
+						},
+						"interval": schema.Int64Attribute{
+							MarkdownDescription: "Interval in minutes.",
+							Computed:            true,
+						},
+						"last_execution": schema.StringAttribute{
+							MarkdownDescription: "Last execution time.",
+							Computed:            true,
+						},
+						"last_start_time": schema.StringAttribute{
+							MarkdownDescription: "Last start time.",
+							Computed:            true,
+						},
+						"last_duration": schema.StringAttribute{
+							MarkdownDescription: "Last duration.",
+							Computed:            true,
+						},
+						"next_execution": schema.StringAttribute{
+							MarkdownDescription: "Next execution time.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *SystemTasksDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if client := helpers.DataSourceConfigure(ctx, req, resp); client != nil {
+		d.client = client
+	}
+}
+
+func (d *SystemTasksDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Get system tasks current value
+	response, _, err := d.client.TaskAPI.ListSystemTask(ctx).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.List, systemTasksDataSourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+systemTasksDataSourceName)
+
+	tasks := make([]SystemTask, len(response))
+	for i := range response {
+		tasks[i].write(&response[i])
+	}
+
+	taskList, diags := types.SetValueFrom(ctx, SystemTask{}.getType(), tasks)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, SystemTasks{SystemTasks: taskList, ID: types.StringValue(strconv.Itoa(len(response)))})...)
+}
+
+func (t *SystemTask) write(task *readarr.TaskResource) {
+	t.ID = types.Int64Value(int64(task.GetId()))
+	t.Name = types.StringValue(task.GetName())
+	t.TaskName = types.StringValue(task.GetTaskName())
+	t.Interval = types.Int64Value(int64(task.GetInterval()))
+	t.LastExecution = helpers.TimeValue(task.GetLastExecution())
+	t.LastStartTime = helpers.TimeValue(task.GetLastStartTime())
+	t.LastDuration = types.StringValue(task.GetLastDuration())
+	t.NextExecution = helpers.TimeValue(task.GetNextExecution())
+}
@@ -0,0 +1,37 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSystemTasksDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized
+			{
+				Config:      testAccSystemTasksDataSourceConfig + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Read testing
+			{
+				Config: testAccSystemTasksDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.readarr_system_tasks.test", "id"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.readarr_system_tasks.test", "system_tasks.*", map[string]string{"task_name": "RssSync"}),
+				),
+			},
+		},
+	})
+}
+
+const testAccSystemTasksDataSourceConfig = `
+data "readarr_system_tasks" "test" {
+}
+`
@@ -0,0 +1,100 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/devopsarr/readarr-go/readarr"
+	"github.com/devopsarr/terraform-provider-readarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const uiConfigDataSourceName = "ui_config"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &UIConfigDataSource{}
+
+func NewUIConfigDataSource() datasource.DataSource {
+	return &UIConfigDataSource{}
+}
+
+// UIConfigDataSource defines the UI config implementation.
+type UIConfigDataSource struct {
+	client *readarr.APIClient
+}
+
+func (d *UIConfigDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + uiConfigDataSourceName
+}
+
+func (d *UIConfigDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "<!-- subcategory:UI -->[UI Config](../resources/ui_config).",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "UI Config ID.",
+				Computed:            true,
+			},
+			"first_day_of_week": schema.Int64Attribute{
+				MarkdownDescription: "First day of week.",
+				Computed:            true,
+			},
+			"calendar_week_column_header": schema.StringAttribute{
+				MarkdownDescription: "Calendar week column header.",
+				Computed:            true,
+			},
+			"short_date_format": schema.StringAttribute{
+				MarkdownDescription: "Short date format.",
+				Computed:            true,
+			},
+			"long_date_format": schema.StringAttribute{
+				MarkdownDescription: "Long date format.",
+				Computed:            true,
+			},
+			"time_format": schema.StringAttribute{
+				MarkdownDescription: "Time format.",
+				Computed:            true,
+			},
+			"show_relative_dates": schema.BoolAttribute{
+				MarkdownDescription: "Show relative dates.",
+				Computed:            true,
+			},
+			"enable_color_impaired_mode": schema.BoolAttribute{
+				MarkdownDescription: "Enable color impaired mode.",
+				Computed:            true,
+			},
+			"ui_language": schema.Int64Attribute{
+				MarkdownDescription: "UI language ID.",
+				Computed:            true,
+			},
+			"theme": schema.StringAttribute{
+				MarkdownDescription: "Theme.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *UIConfigDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if client := helpers.DataSourceConfigure(ctx, req, resp); client != nil {
+		d.client = client
+	}
+}
+
+func (d *UIConfigDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Get ui config current value
+	response, _, err := d.client.UiConfigAPI.GetUiConfig(ctx).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, uiConfigDataSourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+uiConfigDataSourceName)
+
+	config := UIConfig{}
+	config.write(response)
+	resp.Diagnostics.Append(resp.State.Set(ctx, config)...)
+}
@@ -0,0 +1,248 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/devopsarr/readarr-go/readarr"
+	"github.com/devopsarr/terraform-provider-readarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const uiConfigResourceName = "ui_config"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &UIConfigResource{}
+	_ resource.ResourceWithImportState = &UIConfigResource{}
+)
+
+func NewUIConfigResource() resource.Resource {
+	return &UIConfigResource{}
+}
+
+// UIConfigResource defines the UI config implementation.
+type UIConfigResource struct {
+	client *readarr.APIClient
+}
+
+// UIConfig describes the UI config data model.
+type UIConfig struct {
+	CalendarWeekColumnHeader types.String `tfsdk:"calendar_week_column_header"`
+	ShortDateFormat          types.String `tfsdk:"short_date_format"`
+	LongDateFormat           types.String `tfsdk:"long_date_format"`
+	TimeFormat               types.String `tfsdk:"time_format"`
+	Theme                    types.String `tfsdk:"theme"`
+	ID                       types.Int64  `tfsdk:"id"`
+	FirstDayOfWeek           types.Int64  `tfsdk:"first_day_of_week"`
+	UILanguage               types.Int64  `tfsdk:"ui_language"`
+	ShowRelativeDates        types.Bool   `tfsdk:"show_relative_dates"`
+	EnableColorImpairedMode  types.Bool   `tfsdk:"enable_color_impaired_mode"`
+}
+
+func (r *UIConfigResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + uiConfigResourceName
+}
+
+func (r *UIConfigResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "<!-- subcategory:UI -->UI Config resource.\nFor more information refer to [UI](https://wiki.servarr.com/readarr/settings#ui) documentation.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "UI Config ID.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"first_day_of_week": schema.Int64Attribute{
+				MarkdownDescription: "First day of week. `0` Sunday, `1` Monday.",
+				Required:            true,
+				Validators: []validator.Int64{
+					int64validator.OneOf(0, 1),
+				},
+			},
+			"calendar_week_column_header": schema.StringAttribute{
+				MarkdownDescription: "Calendar week column header.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("ddd M/D", "ddd MM/DD", "ddd D/M", "ddd DD/MM"),
+				},
+			},
+			"short_date_format": schema.StringAttribute{
+				MarkdownDescription: "Short date format.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("MMM D YYYY", "DD MMM YYYY", "MM/D/YYYY", "MM/DD/YYYY", "DD/MM/YYYY", "YYYY-MM-DD"),
+				},
+			},
+			"long_date_format": schema.StringAttribute{
+				MarkdownDescription: "Long date format.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("dddd, MMMM D YYYY", "dddd, D MMMM YYYY"),
+				},
+			},
+			"time_format": schema.StringAttribute{
+				MarkdownDescription: "Time format.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("h(:mm)a", "HH:mm"),
+				},
+			},
+			"show_relative_dates": schema.BoolAttribute{
+				MarkdownDescription: "Show relative dates.",
+				Required:            true,
+			},
+			"enable_color_impaired_mode": schema.BoolAttribute{
+				MarkdownDescription: "Enable color impaired mode.",
+				Required:            true,
+			},
+			"ui_language": schema.Int64Attribute{
+				MarkdownDescription: "UI language ID.",
+				Required:            true,
+			},
+			"theme": schema.StringAttribute{
+				MarkdownDescription: "Theme.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("auto", "dark", "light"),
+				},
+			},
+		},
+	}
+}
+
+func (r *UIConfigResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
+		r.client = client
+	}
+}
+
+func (r *UIConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var config *UIConfig
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Build Create resource
+	request := config.read()
+	request.SetId(1)
+
+	// Create new UIConfig
+	response, _, err := r.client.UiConfigAPI.UpdateUiConfig(ctx, strconv.Itoa(int(request.GetId()))).UiConfigResource(*request).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, uiConfigResourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "created "+uiConfigResourceName+": "+strconv.Itoa(int(response.GetId())))
+	// Generate resource state struct
+	config.write(response)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+func (r *UIConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var config *UIConfig
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get uiConfig current value
+	response, _, err := r.client.UiConfigAPI.GetUiConfig(ctx).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, uiConfigResourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+uiConfigResourceName+": "+strconv.Itoa(int(response.GetId())))
+	// Map response body to resource schema attribute
+	config.write(response)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+func (r *UIConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Get plan values
+	var config *UIConfig
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Build Update resource
+	request := config.read()
+
+	// Update UIConfig
+	response, _, err := r.client.UiConfigAPI.UpdateUiConfig(ctx, strconv.Itoa(int(request.GetId()))).UiConfigResource(*request).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, uiConfigResourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "updated "+uiConfigResourceName+": "+strconv.Itoa(int(response.GetId())))
+	// Generate resource state struct
+	config.write(response)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+func (r *UIConfigResource) Delete(ctx context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// UIConfig cannot be really deleted just removing configuration
+	tflog.Trace(ctx, "decoupled "+uiConfigResourceName+": 1")
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *UIConfigResource) ImportState(ctx context.Context, _ resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Trace(ctx, "imported "+uiConfigResourceName+": 1")
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), 1)...)
+}
+
+func (c *UIConfig) write(uiConfig *readarr.UiConfigResource) {
+	c.ID = types.Int64Value(int64(uiConfig.GetId()))
+	c.FirstDayOfWeek = types.Int64Value(int64(uiConfig.GetFirstDayOfWeek()))
+	c.CalendarWeekColumnHeader = types.StringValue(uiConfig.GetCalendarWeekColumnHeader())
+	c.ShortDateFormat = types.StringValue(uiConfig.GetShortDateFormat())
+	c.LongDateFormat = types.StringValue(uiConfig.GetLongDateFormat())
+	c.TimeFormat = types.StringValue(uiConfig.GetTimeFormat())
+	c.ShowRelativeDates = types.BoolValue(uiConfig.GetShowRelativeDates())
+	c.EnableColorImpairedMode = types.BoolValue(uiConfig.GetEnableColorImpairedMode())
+	c.UILanguage = types.Int64Value(int64(uiConfig.GetUiLanguage()))
+	c.Theme = types.StringValue(uiConfig.GetTheme())
+}
+
+func (c *UIConfig) read() *readarr.UiConfigResource {
+	config := readarr.NewUiConfigResource()
+	config.SetFirstDayOfWeek(int32(c.FirstDayOfWeek.ValueInt64()))
+	config.SetCalendarWeekColumnHeader(c.CalendarWeekColumnHeader.ValueString())
+	config.SetShortDateFormat(c.ShortDateFormat.ValueString())
+	config.SetLongDateFormat(c.LongDateFormat.ValueString())
+	config.SetTimeFormat(c.TimeFormat.ValueString())
+	config.SetShowRelativeDates(c.ShowRelativeDates.ValueBool())
+	config.SetEnableColorImpairedMode(c.EnableColorImpairedMode.ValueBool())
+	config.SetUiLanguage(int32(c.UILanguage.ValueInt64()))
+	config.SetTheme(c.Theme.ValueString())
+	config.SetId(int32(c.ID.ValueInt64()))
+
+	return config
+}
@@ -0,0 +1,65 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/devopsarr/readarr-go/readarr"
+	"github.com/devopsarr/terraform-provider-readarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const wantedCutoffDataSourceName = "wanted_cutoff"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &WantedCutoffDataSource{}
+
+func NewWantedCutoffDataSource() datasource.DataSource {
+	return &WantedCutoffDataSource{}
+}
+
+// WantedCutoffDataSource defines the wanted cutoff implementation.
+type WantedCutoffDataSource struct {
+	client *readarr.APIClient
+}
+
+func (d *WantedCutoffDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + wantedCutoffDataSourceName
+}
+
+func (d *WantedCutoffDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "<!-- subcategory:Wanted -->List all books whose files do not meet the quality profile cutoff.",
+		Attributes:          wantedDataSourceSchema(),
+	}
+}
+
+func (d *WantedCutoffDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if client := helpers.DataSourceConfigure(ctx, req, resp); client != nil {
+		d.client = client
+	}
+}
+
+func (d *WantedCutoffDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *WantedBooks
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get wanted cutoff current value
+	response, err := listPaged[readarr.BookResource](ctx, d.client, "CutoffAPIService.GetWantedCutoff", "/api/v1/wanted/cutoff", data.query())
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.List, wantedCutoffDataSourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+wantedCutoffDataSourceName)
+	data.write(ctx, response, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
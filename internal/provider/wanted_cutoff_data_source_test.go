@@ -0,0 +1,40 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccWantedCutoffDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized
+			{
+				Config:      testAccWantedCutoffDataSourceConfig("true") + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Read testing
+			{
+				Config: testAccWantedCutoffDataSourceConfig("false"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.readarr_wanted_cutoff.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccWantedCutoffDataSourceConfig(monitored string) string {
+	return fmt.Sprintf(`
+	data "readarr_wanted_cutoff" "test" {
+		monitored = %s
+	}
+	`, monitored)
+}
@@ -0,0 +1,206 @@
+package provider
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+
+	"github.com/devopsarr/readarr-go/readarr"
+	"github.com/devopsarr/terraform-provider-readarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const wantedMissingDataSourceName = "wanted_missing"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &WantedMissingDataSource{}
+
+func NewWantedMissingDataSource() datasource.DataSource {
+	return &WantedMissingDataSource{}
+}
+
+// WantedMissingDataSource defines the wanted missing implementation.
+type WantedMissingDataSource struct {
+	client *readarr.APIClient
+}
+
+// WantedBooks describes the wanted books data model.
+type WantedBooks struct {
+	Books     types.Set    `tfsdk:"books"`
+	ID        types.String `tfsdk:"id"`
+	AuthorID  types.Int64  `tfsdk:"author_id"`
+	Monitored types.Bool   `tfsdk:"monitored"`
+}
+
+// WantedBook is part of WantedBooks.
+type WantedBook struct {
+	Title           types.String `tfsdk:"title"`
+	ForeignBookID   types.String `tfsdk:"foreign_book_id"`
+	AuthorName      types.String `tfsdk:"author_name"`
+	ForeignAuthorID types.String `tfsdk:"foreign_author_id"`
+	ReleaseDate     types.String `tfsdk:"release_date"`
+	ID              types.Int64  `tfsdk:"id"`
+	AuthorID        types.Int64  `tfsdk:"author_id"`
+	Monitored       types.Bool   `tfsdk:"monitored"`
+}
+
+func (b WantedBook) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"title":             types.StringType,
+			"foreign_book_id":   types.StringType,
+			"author_name":       types.StringType,
+			"foreign_author_id": types.StringType,
+			"release_date":      types.StringType,
+			"id":                types.Int64Type,
+			"author_id":         types.Int64Type,
+			"monitored":         types.BoolType,
+		})
+}
+
+func (d *WantedMissingDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + wantedMissingDataSourceName
+}
+
+func (d *WantedMissingDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "<!-- subcategory:Wanted -->List all books missing from disk.",
+		Attributes:          wantedDataSourceSchema(),
+	}
+}
+
+func wantedDataSourceSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
+		"id": schema.StringAttribute{
+			Computed: true,
+		},
+		"author_id": schema.Int64Attribute{
+			MarkdownDescription: "Filter by author ID.",
+			Optional:            true,
+		},
+		"monitored": schema.BoolAttribute{
+			MarkdownDescription: "Filter by monitored flag. If not set, only monitored books are returned.",
+			Optional:            true,
+		},
+		"books": schema.SetNestedAttribute{
+			MarkdownDescription: "Book list.",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.Int64Attribute{
+						MarkdownDescription: "Book ID.",
+						Computed:            true,
+					},
+					"title": schema.StringAttribute{
+						MarkdownDescription: "Book title.",
+						Computed:            true,
+					},
+					"foreign_book_id": schema.StringAttribute{
+						MarkdownDescription: "Foreign book ID.",
+						Computed:            true,
+					},
+					"author_id": schema.Int64Attribute{
+						MarkdownDescription: "Author ID.",
+						Computed:            true,
+					},
+					"author_name": schema.StringAttribute{
+						MarkdownDescription: "Author name.",
+						Computed:            true,
+					},
+					"foreign_author_id": schema.StringAttribute{
+						MarkdownDescription: "Foreign author ID.",
+						Computed:            true,
+					},
+					"release_date": schema.StringAttribute{
+						MarkdownDescription: "Release date.",
+						Computed:            true,
+					},
+					"monitored": schema.BoolAttribute{
+						MarkdownDescription: "Monitored flag.",
+						Computed:            true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *WantedMissingDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if client := helpers.DataSourceConfigure(ctx, req, resp); client != nil {
+		d.client = client
+	}
+}
+
+func (d *WantedMissingDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *WantedBooks
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get wanted missing current value
+	response, err := listPaged[readarr.BookResource](ctx, d.client, "MissingAPIService.GetWantedMissing", "/api/v1/wanted/missing", data.query())
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.List, wantedMissingDataSourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+wantedMissingDataSourceName)
+	data.write(ctx, response, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// query returns the query parameters for the wanted endpoints.
+func (w *WantedBooks) query() url.Values {
+	query := url.Values{}
+	query.Set("includeAuthor", "true")
+
+	if !w.Monitored.IsNull() {
+		query.Set("monitored", strconv.FormatBool(w.Monitored.ValueBool()))
+	}
+
+	return query
+}
+
+func (w *WantedBooks) write(ctx context.Context, books []readarr.BookResource, diags *diag.Diagnostics) {
+	wanted := make([]WantedBook, 0, len(books))
+
+	for i := range books {
+		if !w.AuthorID.IsNull() && int64(books[i].GetAuthorId()) != w.AuthorID.ValueInt64() {
+			continue
+		}
+
+		book := WantedBook{}
+		book.write(&books[i])
+		wanted = append(wanted, book)
+	}
+
+	bookList, tempDiag := types.SetValueFrom(ctx, WantedBook{}.getType(), wanted)
+	diags.Append(tempDiag...)
+
+	w.Books = bookList
+	w.ID = types.StringValue(strconv.Itoa(len(wanted)))
+}
+
+func (b *WantedBook) write(book *readarr.BookResource) {
+	author := book.GetAuthor()
+
+	b.ID = types.Int64Value(int64(book.GetId()))
+	b.Title = types.StringValue(book.GetTitle())
+	b.ForeignBookID = types.StringValue(book.GetForeignBookId())
+	b.AuthorID = types.Int64Value(int64(book.GetAuthorId()))
+	b.AuthorName = types.StringValue(author.GetAuthorName())
+	b.ForeignAuthorID = types.StringValue(author.GetForeignAuthorId())
+	b.ReleaseDate = helpers.TimeValue(book.GetReleaseDate())
+	b.Monitored = types.BoolValue(book.GetMonitored())
+}